@@ -1052,7 +1052,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 			// []string -> types.List(OfString).
 			//
 			if vFrom.IsNil() {
-				vTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
+				to, d := tTo.ValueFromList(ctx, types.ListNull(types.StringType))
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				vTo.Set(reflect.ValueOf(to))
 				return diags
 			}
 
@@ -1080,7 +1086,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 			// []string -> types.Set(OfString).
 			//
 			if vFrom.IsNil() {
-				vTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
+				to, d := tTo.ValueFromSet(ctx, types.SetNull(types.StringType))
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				vTo.Set(reflect.ValueOf(to))
 				return diags
 			}
 
@@ -1113,7 +1125,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 				// []*string -> types.List(OfString).
 				//
 				if vFrom.IsNil() {
-					vTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
+					to, d := tTo.ValueFromList(ctx, types.ListNull(types.StringType))
+					diags.Append(d...)
+					if diags.HasError() {
+						return diags
+					}
+
+					vTo.Set(reflect.ValueOf(to))
 					return diags
 				}
 
@@ -1142,7 +1160,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 				// []string -> types.Set(OfString).
 				//
 				if vFrom.IsNil() {
-					vTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
+					to, d := tTo.ValueFromSet(ctx, types.SetNull(types.StringType))
+					diags.Append(d...)
+					if diags.HasError() {
+						return diags
+					}
+
+					vTo.Set(reflect.ValueOf(to))
 					return diags
 				}
 
@@ -1209,7 +1233,13 @@ func (flattener autoFlattener) map_(ctx context.Context, vFrom reflect.Value, tT
 				// map[string]string -> types.Map(OfString).
 				//
 				if vFrom.IsNil() {
-					vTo.Set(reflect.ValueOf(types.MapNull(types.StringType)))
+					to, d := tTo.ValueFromMap(ctx, types.MapNull(types.StringType))
+					diags.Append(d...)
+					if diags.HasError() {
+						return diags
+					}
+
+					vTo.Set(reflect.ValueOf(to))
 					return diags
 				}
 
@@ -1243,7 +1273,13 @@ func (flattener autoFlattener) map_(ctx context.Context, vFrom reflect.Value, tT
 					// map[string]*string -> types.Map(OfString).
 					//
 					if vFrom.IsNil() {
-						vTo.Set(reflect.ValueOf(types.MapNull(types.StringType)))
+						to, d := tTo.ValueFromMap(ctx, types.MapNull(types.StringType))
+						diags.Append(d...)
+						if diags.HasError() {
+							return diags
+						}
+
+						vTo.Set(reflect.ValueOf(to))
 						return diags
 					}
 
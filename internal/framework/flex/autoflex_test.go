@@ -566,6 +566,15 @@ type TestFlexTF15 struct {
 	FieldOuter fwtypes.ListNestedObjectValueOf[TestFlexTF12] `tfsdk:"field_outer"`
 }
 
+type TestFlexTF16 struct {
+	Field1 fwtypes.ListValueOf[types.String] `tfsdk:"field1"`
+	Field2 fwtypes.ListValueOf[types.String] `tfsdk:"field2"`
+	Field3 fwtypes.SetValueOf[types.String]  `tfsdk:"field3"`
+	Field4 fwtypes.SetValueOf[types.String]  `tfsdk:"field4"`
+	Field5 fwtypes.MapValueOf[types.String]  `tfsdk:"field5"`
+	Field6 fwtypes.MapValueOf[types.String]  `tfsdk:"field6"`
+}
+
 type TestFlexAWS13 struct {
 	FieldInner map[string]string
 }
@@ -1127,6 +1136,39 @@ func TestGenericFlatten(t *testing.T) {
 				}),
 			},
 		},
+		{
+			TestName: "zero value slice/map of primtive types Source and custom List/Set/Map of primtive types Target",
+			Source:   &TestFlexAWS05{},
+			Target:   &TestFlexTF16{},
+			WantTarget: &TestFlexTF16{
+				Field1: fwtypes.NewListValueOfNull[types.String](ctx),
+				Field2: fwtypes.NewListValueOfNull[types.String](ctx),
+				Field3: fwtypes.NewSetValueOfNull[types.String](ctx),
+				Field4: fwtypes.NewSetValueOfNull[types.String](ctx),
+				Field5: fwtypes.NewMapValueOfNull[types.String](ctx),
+				Field6: fwtypes.NewMapValueOfNull[types.String](ctx),
+			},
+		},
+		{
+			TestName: "slice/map of primtive types Source and custom List/Set/Map of primtive types Target",
+			Source: &TestFlexAWS05{
+				Field1: []string{"a", "b"},
+				Field2: aws.StringSlice([]string{"a", "b"}),
+				Field3: []string{"a", "b"},
+				Field4: aws.StringSlice([]string{"a", "b"}),
+				Field5: map[string]string{"A": "a", "B": "b"},
+				Field6: aws.StringMap(map[string]string{"A": "a", "B": "b"}),
+			},
+			Target: &TestFlexTF16{},
+			WantTarget: &TestFlexTF16{
+				Field1: fwtypes.NewListValueOf(ctx, []types.String{types.StringValue("a"), types.StringValue("b")}),
+				Field2: fwtypes.NewListValueOf(ctx, []types.String{types.StringValue("a"), types.StringValue("b")}),
+				Field3: fwtypes.NewSetValueOf(ctx, []types.String{types.StringValue("a"), types.StringValue("b")}),
+				Field4: fwtypes.NewSetValueOf(ctx, []types.String{types.StringValue("a"), types.StringValue("b")}),
+				Field5: fwtypes.NewMapValueOf(ctx, map[string]types.String{"A": types.StringValue("a"), "B": types.StringValue("b")}),
+				Field6: fwtypes.NewMapValueOf(ctx, map[string]types.String{"A": types.StringValue("a"), "B": types.StringValue("b")}),
+			},
+		},
 		{
 			TestName: "capitalization field names",
 			Source: &TestFlexAWS12{
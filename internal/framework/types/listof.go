@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+var _ basetypes.ListTypable = ListTypeOf[struct{}]{}
+
+// ListTypeOf is the attribute type of a ListValueOf.
+type ListTypeOf[T any] struct {
+	basetypes.ListType
+}
+
+func NewListTypeOf[T any](ctx context.Context) ListTypeOf[T] {
+	return ListTypeOf[T]{basetypes.ListType{ElemType: ElementTypeMust[T](ctx)}}
+}
+
+func (t ListTypeOf[T]) Equal(o attr.Type) bool {
+	other, ok := o.(ListTypeOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t ListTypeOf[T]) String() string {
+	var zero T
+	return fmt.Sprintf("%T", zero)
+}
+
+func (t ListTypeOf[T]) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return NewListValueOfNull[T](ctx), diags
+	}
+	if in.IsUnknown() {
+		return NewListValueOfUnknown[T](ctx), diags
+	}
+
+	listValue, d := basetypes.NewListValue(ElementTypeMust[T](ctx), in.Elements())
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewListValueOfUnknown[T](ctx), diags
+	}
+
+	value := ListValueOf[T]{
+		ListValue: listValue,
+	}
+
+	return value, diags
+}
+
+func (t ListTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	listValuable, diags := t.ValueFromList(ctx, listValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
+	}
+
+	return listValuable, nil
+}
+
+func (t ListTypeOf[T]) ValueType(ctx context.Context) attr.Value {
+	return ListValueOf[T]{}
+}
+
+var _ basetypes.ListValuable = ListValueOf[struct{}]{}
+
+// ListValueOf represents a Terraform Plugin Framework List value whose elements are of type T.
+type ListValueOf[T any] struct {
+	basetypes.ListValue
+}
+
+func (v ListValueOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(ListValueOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.ListValue.Equal(other.ListValue)
+}
+
+func (v ListValueOf[T]) Type(ctx context.Context) attr.Type {
+	return NewListTypeOf[T](ctx)
+}
+
+func NewListValueOfNull[T any](ctx context.Context) ListValueOf[T] {
+	return ListValueOf[T]{ListValue: basetypes.NewListNull(ElementTypeMust[T](ctx))}
+}
+
+func NewListValueOfUnknown[T any](ctx context.Context) ListValueOf[T] {
+	return ListValueOf[T]{ListValue: basetypes.NewListUnknown(ElementTypeMust[T](ctx))}
+}
+
+func NewListValueOf[T any](ctx context.Context, elements []T) ListValueOf[T] {
+	return ListValueOf[T]{ListValue: fwdiag.Must(basetypes.NewListValueFrom(ctx, ElementTypeMust[T](ctx), elements))}
+}
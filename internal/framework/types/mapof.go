@@ -52,7 +52,7 @@ func (t MapTypeOf[T]) ValueFromMap(ctx context.Context, in basetypes.MapValue) (
 		return NewMapValueOfUnknown[T](ctx), diags
 	}
 
-	mapValue, d := basetypes.NewMapValue(NewMapTypeOf[T](ctx), in.Elements())
+	mapValue, d := basetypes.NewMapValue(ElementTypeMust[T](ctx), in.Elements())
 	diags.Append(d...)
 	if diags.HasError() {
 		return NewMapValueOfUnknown[T](ctx), diags
@@ -113,11 +113,11 @@ func (v MapValueOf[T]) Type(ctx context.Context) attr.Type {
 }
 
 func NewMapValueOfNull[T any](ctx context.Context) MapValueOf[T] {
-	return MapValueOf[T]{MapValue: basetypes.NewMapNull(NewMapTypeOf[T](ctx))}
+	return MapValueOf[T]{MapValue: basetypes.NewMapNull(ElementTypeMust[T](ctx))}
 }
 
 func NewMapValueOfUnknown[T any](ctx context.Context) MapValueOf[T] {
-	return MapValueOf[T]{MapValue: basetypes.NewMapUnknown(NewMapTypeOf[T](ctx))}
+	return MapValueOf[T]{MapValue: basetypes.NewMapUnknown(ElementTypeMust[T](ctx))}
 }
 
 func NewMapValueOf[T any](ctx context.Context, elements map[string]T) MapValueOf[T] {
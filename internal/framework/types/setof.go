@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+var _ basetypes.SetTypable = SetTypeOf[struct{}]{}
+
+// SetTypeOf is the attribute type of a SetValueOf.
+type SetTypeOf[T any] struct {
+	basetypes.SetType
+}
+
+func NewSetTypeOf[T any](ctx context.Context) SetTypeOf[T] {
+	return SetTypeOf[T]{basetypes.SetType{ElemType: ElementTypeMust[T](ctx)}}
+}
+
+func (t SetTypeOf[T]) Equal(o attr.Type) bool {
+	other, ok := o.(SetTypeOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.SetType.Equal(other.SetType)
+}
+
+func (t SetTypeOf[T]) String() string {
+	var zero T
+	return fmt.Sprintf("%T", zero)
+}
+
+func (t SetTypeOf[T]) ValueFromSet(ctx context.Context, in basetypes.SetValue) (basetypes.SetValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return NewSetValueOfNull[T](ctx), diags
+	}
+	if in.IsUnknown() {
+		return NewSetValueOfUnknown[T](ctx), diags
+	}
+
+	setValue, d := basetypes.NewSetValue(ElementTypeMust[T](ctx), in.Elements())
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewSetValueOfUnknown[T](ctx), diags
+	}
+
+	value := SetValueOf[T]{
+		SetValue: setValue,
+	}
+
+	return value, diags
+}
+
+func (t SetTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.SetType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	setValue, ok := attrValue.(basetypes.SetValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	setValuable, diags := t.ValueFromSet(ctx, setValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting SetValue to SetValuable: %v", diags)
+	}
+
+	return setValuable, nil
+}
+
+func (t SetTypeOf[T]) ValueType(ctx context.Context) attr.Value {
+	return SetValueOf[T]{}
+}
+
+var _ basetypes.SetValuable = SetValueOf[struct{}]{}
+
+// SetValueOf represents a Terraform Plugin Framework Set value whose elements are of type T.
+type SetValueOf[T any] struct {
+	basetypes.SetValue
+}
+
+func (v SetValueOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(SetValueOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.SetValue.Equal(other.SetValue)
+}
+
+func (v SetValueOf[T]) Type(ctx context.Context) attr.Type {
+	return NewSetTypeOf[T](ctx)
+}
+
+func NewSetValueOfNull[T any](ctx context.Context) SetValueOf[T] {
+	return SetValueOf[T]{SetValue: basetypes.NewSetNull(ElementTypeMust[T](ctx))}
+}
+
+func NewSetValueOfUnknown[T any](ctx context.Context) SetValueOf[T] {
+	return SetValueOf[T]{SetValue: basetypes.NewSetUnknown(ElementTypeMust[T](ctx))}
+}
+
+func NewSetValueOf[T any](ctx context.Context, elements []T) SetValueOf[T] {
+	return SetValueOf[T]{SetValue: fwdiag.Must(basetypes.NewSetValueFrom(ctx, ElementTypeMust[T](ctx), elements))}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestSetTypeOfValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		tfVal   tftypes.Value
+		wantVal attr.Value
+		wantErr bool
+	}{
+		"null value": {
+			tfVal:   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			wantVal: fwtypes.NewSetValueOfNull[types.String](ctx),
+		},
+		"unknown value": {
+			tfVal:   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+			wantVal: fwtypes.NewSetValueOfUnknown[types.String](ctx),
+		},
+		"valid value": {
+			tfVal: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+			}),
+			wantVal: fwtypes.NewSetValueOf[types.String](ctx, []types.String{types.StringValue("a"), types.StringValue("b")}),
+		},
+		"invalid Terraform value": {
+			tfVal: tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 42),
+			}),
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotVal, err := fwtypes.NewSetTypeOf[types.String](ctx).ValueFromTerraform(ctx, testCase.tfVal)
+			gotErr := err != nil
+
+			if gotErr != testCase.wantErr {
+				t.Errorf("gotErr = %v, wantErr = %v", gotErr, testCase.wantErr)
+			}
+
+			if gotErr {
+				if !testCase.wantErr {
+					t.Errorf("err = %q", err)
+				}
+			} else if diff := cmp.Diff(gotVal, testCase.wantVal); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,568 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Model")
+// @Tags(identifierAttribute="job_arn")
+func newCustomModelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &customModelResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type customModelResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *customModelResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_custom_model"
+}
+
+func (r *customModelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s3URIBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"s3_uri": schema.StringAttribute{
+						Required: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	outputDataConfigBlock := s3URIBlock()
+	outputDataConfigBlock.CustomType = fwtypes.NewListNestedObjectTypeOf[s3URIConfigModel](ctx)
+	outputDataConfigBlock.Validators = []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtLeast(1),
+		listvalidator.SizeAtMost(1),
+	}
+
+	trainingDataConfigBlock := s3URIBlock()
+	trainingDataConfigBlock.CustomType = fwtypes.NewListNestedObjectTypeOf[s3URIConfigModel](ctx)
+	trainingDataConfigBlock.Validators = []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtLeast(1),
+		listvalidator.SizeAtMost(1),
+	}
+
+	validatorBlock := s3URIBlock()
+	validatorBlock.CustomType = fwtypes.NewListNestedObjectTypeOf[s3URIConfigModel](ctx)
+	validatorBlock.Validators = []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtLeast(1),
+		listvalidator.SizeAtMost(10),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"base_model_identifier": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_model_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_model_kms_key_id": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_model_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"customization_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CustomizationType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hyperparameters": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ModelCustomizationJobStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"training_metrics": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[trainingMetricsModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[trainingMetricsModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validation_metrics": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[validatorMetricModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[validatorMetricModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"output_data_config":   outputDataConfigBlock,
+			"timeouts":             timeouts.Block(ctx, timeouts.Opts{Create: true, Delete: true}),
+			"training_data_config": trainingDataConfigBlock,
+			"validation_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[validationDataConfigModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"validator": validatorBlock,
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"vpc_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcConfigModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"security_group_ids": schema.SetAttribute{
+							CustomType:  fwtypes.NewSetTypeOf[types.String](ctx),
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+						"subnet_ids": schema.SetAttribute{
+							CustomType:  fwtypes.NewSetTypeOf[types.String](ctx),
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *customModelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customModelResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	input := &bedrock.CreateModelCustomizationJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientRequestToken = aws.String(sdkid.UniqueId())
+	input.CustomModelTags = getTagsIn(ctx)
+	input.JobTags = getTagsIn(ctx)
+
+	// IAM role propagation.
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ValidationException](ctx, iamPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateModelCustomizationJob(ctx, input)
+	}, "Could not assume provided IAM role")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Custom Model (%s)", data.CustomModelName.ValueString()), err.Error())
+
+		return
+	}
+
+	jobARN := aws.ToString(outputRaw.(*bedrock.CreateModelCustomizationJobOutput).JobArn)
+	data.ID = types.StringValue(jobARN)
+
+	job, err := waitModelCustomizationJobCompleted(ctx, conn, jobARN, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Custom Model customization job (%s) complete", jobARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, job)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customModelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customModelResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	job, err := findModelCustomizationJobByID(ctx, conn, data.ID.ValueString())
+
+	if err == nil && job.Status == awstypes.ModelCustomizationJobStatusCompleted {
+		// The customization job outlives the model it produces.
+		_, err = findCustomModelByID(ctx, conn, aws.ToString(job.OutputModelArn))
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, job)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customModelResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Tags only.
+}
+
+func (r *customModelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customModelResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	if data.JobStatus.ValueEnum() == awstypes.ModelCustomizationJobStatusInProgress {
+		_, err := conn.StopModelCustomizationJob(ctx, &bedrock.StopModelCustomizationJobInput{
+			JobIdentifier: aws.String(data.ID.ValueString()),
+		})
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping Bedrock Custom Model customization job (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitModelCustomizationJobStopped(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Custom Model customization job (%s) stop", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if data.CustomModelARN.IsNull() || data.CustomModelARN.ValueString() == "" {
+		return
+	}
+
+	_, err := conn.DeleteCustomModel(ctx, &bedrock.DeleteCustomModelInput{
+		ModelIdentifier: aws.String(data.CustomModelARN.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Custom Model (%s)", data.CustomModelARN.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *customModelResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCustomModelByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetCustomModelOutput, error) {
+	input := &bedrock.GetCustomModelInput{
+		ModelIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCustomModel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findModelCustomizationJobByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetModelCustomizationJobOutput, error) {
+	input := &bedrock.GetModelCustomizationJobInput{
+		JobIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetModelCustomizationJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusModelCustomizationJob(ctx context.Context, conn *bedrock.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findModelCustomizationJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitModelCustomizationJobCompleted(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ModelCustomizationJobStatusInProgress),
+		Target:     enum.Slice(awstypes.ModelCustomizationJobStatusCompleted),
+		Refresh:    statusModelCustomizationJob(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelCustomizationJobStopped(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ModelCustomizationJobStatusInProgress, awstypes.ModelCustomizationJobStatusStopping),
+		Target:  enum.Slice(awstypes.ModelCustomizationJobStatusStopped, awstypes.ModelCustomizationJobStatusFailed, awstypes.ModelCustomizationJobStatusCompleted),
+		Refresh: statusModelCustomizationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type customModelResourceModel struct {
+	BaseModelIdentifier  fwtypes.ARN                                                `tfsdk:"base_model_identifier"`
+	CustomModelARN       types.String                                               `tfsdk:"custom_model_arn"`
+	CustomModelKmsKeyID  fwtypes.ARN                                                `tfsdk:"custom_model_kms_key_id"`
+	CustomModelName      types.String                                               `tfsdk:"custom_model_name"`
+	CustomizationType    fwtypes.StringEnum[awstypes.CustomizationType]             `tfsdk:"customization_type"`
+	HyperParameters      types.Map                                                  `tfsdk:"hyperparameters"`
+	ID                   types.String                                               `tfsdk:"id"`
+	JobARN               types.String                                               `tfsdk:"job_arn"`
+	JobName              types.String                                               `tfsdk:"job_name"`
+	JobStatus            fwtypes.StringEnum[awstypes.ModelCustomizationJobStatus]   `tfsdk:"job_status"`
+	OutputDataConfig     fwtypes.ListNestedObjectValueOf[s3URIConfigModel]          `tfsdk:"output_data_config"`
+	RoleARN              fwtypes.ARN                                                `tfsdk:"role_arn"`
+	Tags                 types.Map                                                  `tfsdk:"tags"`
+	TagsAll              types.Map                                                  `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                             `tfsdk:"timeouts"`
+	TrainingDataConfig   fwtypes.ListNestedObjectValueOf[s3URIConfigModel]          `tfsdk:"training_data_config"`
+	TrainingMetrics      fwtypes.ListNestedObjectValueOf[trainingMetricsModel]      `tfsdk:"training_metrics"`
+	ValidationDataConfig fwtypes.ListNestedObjectValueOf[validationDataConfigModel] `tfsdk:"validation_data_config"`
+	ValidationMetrics    fwtypes.ListNestedObjectValueOf[validatorMetricModel]      `tfsdk:"validation_metrics"`
+	VPCConfig            fwtypes.ListNestedObjectValueOf[vpcConfigModel]            `tfsdk:"vpc_config"`
+}
+
+func (data *customModelResourceModel) refreshFromOutput(ctx context.Context, output *bedrock.GetModelCustomizationJobOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The service reports the versioned base model ARN, so only populate the configured value on import.
+	if data.BaseModelIdentifier.IsNull() {
+		data.BaseModelIdentifier = fwtypes.ARNValue(aws.ToString(output.BaseModelArn))
+	}
+	data.CustomModelARN = fwflex.StringToFramework(ctx, output.OutputModelArn)
+	if v := output.OutputModelKmsKeyArn; v != nil && data.CustomModelKmsKeyID.IsNull() {
+		data.CustomModelKmsKeyID = fwtypes.ARNValue(aws.ToString(v))
+	}
+	data.CustomModelName = fwflex.StringToFramework(ctx, output.OutputModelName)
+	data.JobARN = fwflex.StringToFramework(ctx, output.JobArn)
+	data.JobStatus = fwtypes.StringEnumValue(output.Status)
+
+	return diags
+}
+
+type s3URIConfigModel struct {
+	S3URI types.String `tfsdk:"s3_uri"`
+}
+
+type trainingMetricsModel struct {
+	TrainingLoss types.Float64 `tfsdk:"training_loss"`
+}
+
+type validationDataConfigModel struct {
+	Validators fwtypes.ListNestedObjectValueOf[s3URIConfigModel] `tfsdk:"validator"`
+}
+
+type validatorMetricModel struct {
+	ValidationLoss types.Float64 `tfsdk:"validation_loss"`
+}
+
+type vpcConfigModel struct {
+	SecurityGroupIDs fwtypes.SetValueOf[types.String] `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetValueOf[types.String] `tfsdk:"subnet_ids"`
+}
@@ -5,5 +5,14 @@ package bedrock
 
 // Exports for use in tests only.
 var (
+	ResourceCustomModel                         = newCustomModelResource
+	ResourceGuardrail                           = newGuardrailResource
+	ResourceGuardrailVersion                    = newGuardrailVersionResource
 	ResourceModelInvocationLoggingConfiguration = newResourceModelInvocationLoggingConfiguration
+	ResourceProvisionedModelThroughput          = newProvisionedModelThroughputResource
+
+	FindCustomModelByID                = findCustomModelByID
+	FindGuardrailByTwoPartKey          = findGuardrailByTwoPartKey
+	FindModelCustomizationJobByID      = findModelCustomizationJobByID
+	FindProvisionedModelThroughputByID = findProvisionedModelThroughputByID
 )
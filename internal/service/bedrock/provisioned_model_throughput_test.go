@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Provisioned throughput is billed hourly, so these tests run against an existing custom model.
+func TestAccBedrockProvisionedModelThroughput_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	modelARN := acctest.SkipIfEnvVarNotSet(t, "BEDROCK_CUSTOM_MODEL_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_provisioned_model_throughput.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedModelThroughputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedModelThroughputConfig_basic(rName, modelARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "commitment_duration"),
+					resource.TestCheckResourceAttr(resourceName, "model_arn", modelARN),
+					resource.TestCheckResourceAttr(resourceName, "model_units", "1"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "provisioned_model_arn", "bedrock", regexache.MustCompile(`provisioned-model/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccBedrockProvisionedModelThroughput_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	modelARN := acctest.SkipIfEnvVarNotSet(t, "BEDROCK_CUSTOM_MODEL_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_provisioned_model_throughput.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedModelThroughputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedModelThroughputConfig_basic(rName, modelARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrock.ResourceProvisionedModelThroughput, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProvisionedModelThroughputDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrock_provisioned_model_throughput" {
+				continue
+			}
+
+			_, err := tfbedrock.FindProvisionedModelThroughputByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Provisioned Model Throughput %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProvisionedModelThroughputExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		_, err := tfbedrock.FindProvisionedModelThroughputByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccProvisionedModelThroughputConfig_basic(rName, modelARN string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_provisioned_model_throughput" "test" {
+  provisioned_model_name = %[1]q
+  model_arn              = %[2]q
+  model_units            = 1
+}
+`, rName, modelARN)
+}
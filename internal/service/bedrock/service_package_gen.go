@@ -29,6 +29,31 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newCustomModelResource,
+			Name:    "Custom Model",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "job_arn",
+			},
+		},
+		{
+			Factory: newGuardrailResource,
+			Name:    "Guardrail",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "guardrail_arn",
+			},
+		},
+		{
+			Factory: newGuardrailVersionResource,
+			Name:    "Guardrail Version",
+		},
+		{
+			Factory: newProvisionedModelThroughputResource,
+			Name:    "Provisioned Model Throughput",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "provisioned_model_arn",
+			},
+		},
 		{
 			Factory: newResourceModelInvocationLoggingConfiguration,
 			Name:    "Model Invocation Logging Configuration",
@@ -0,0 +1,629 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Agent")
+// @Tags(identifierAttribute="agent_arn")
+func newAgentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &agentResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// The working draft of an agent.
+	agentVersionDraft = "DRAFT"
+)
+
+type agentResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *agentResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_agent"
+}
+
+func (r *agentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"agent_arn": framework.ARNAttributeComputedOnly(),
+			"agent_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"agent_resource_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"agent_version": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"foundation_model": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"idle_session_ttl_in_seconds": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 3600),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"instruction": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(40, 4000),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prepare_agent": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"prompt_override_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[promptOverrideConfigurationModel](ctx),
+				Optional:    true,
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[promptOverrideConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skip_resource_in_use_check": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *agentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data agentResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateAgentInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAgent(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent (%s)", data.AgentName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.Agent.AgentId)
+
+	agent, err := waitAgentCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if data.PrepareAgent.ValueBool() {
+		if agent, err = prepareAgent(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("preparing Bedrock Agent (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, agent)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *agentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data agentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findAgentByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *agentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new agentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.AgentName.Equal(old.AgentName) ||
+		!new.AgentResourceRoleARN.Equal(old.AgentResourceRoleARN) ||
+		!new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Description.Equal(old.Description) ||
+		!new.FoundationModel.Equal(old.FoundationModel) ||
+		!new.IdleSessionTTLInSeconds.Equal(old.IdleSessionTTLInSeconds) ||
+		!new.Instruction.Equal(old.Instruction) ||
+		!new.PromptOverrideConfiguration.Equal(old.PromptOverrideConfiguration) {
+		input := &bedrockagent.UpdateAgentInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.AgentId = aws.String(new.ID.ValueString())
+
+		_, err := conn.UpdateAgent(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		agent, err := waitAgentUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if new.PrepareAgent.ValueBool() {
+			if agent, err = prepareAgent(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("preparing Bedrock Agent (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, agent)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *agentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data agentResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteAgent(ctx, &bedrockagent.DeleteAgentInput{
+		AgentId:                aws.String(data.ID.ValueString()),
+		SkipResourceInUseCheck: data.SkipResourceInUseCheck.ValueBool(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitAgentDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *agentResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func prepareAgent(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	input := &bedrockagent.PrepareAgentInput{
+		AgentId: aws.String(id),
+	}
+
+	if _, err := conn.PrepareAgent(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return waitAgentPrepared(ctx, conn, id, timeout)
+}
+
+func findAgentByID(ctx context.Context, conn *bedrockagent.Client, id string) (*awstypes.Agent, error) {
+	input := &bedrockagent.GetAgentInput{
+		AgentId: aws.String(id),
+	}
+
+	output, err := conn.GetAgent(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Agent == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Agent, nil
+}
+
+func statusAgent(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAgentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.AgentStatus), nil
+	}
+}
+
+func waitAgentCreated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusCreating),
+		Target:  enum.Slice(awstypes.AgentStatusNotPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentUpdated(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusUpdating),
+		Target:  enum.Slice(awstypes.AgentStatusNotPrepared, awstypes.AgentStatusPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusNotPrepared, awstypes.AgentStatusPreparing),
+		Target:  enum.Slice(awstypes.AgentStatusPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentDeleted(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusDeleting),
+		Target:  []string{},
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type agentResourceModel struct {
+	AgentARN                    types.String                                                      `tfsdk:"agent_arn"`
+	AgentID                     types.String                                                      `tfsdk:"agent_id"`
+	AgentName                   types.String                                                      `tfsdk:"agent_name"`
+	AgentResourceRoleARN        fwtypes.ARN                                                       `tfsdk:"agent_resource_role_arn"`
+	AgentVersion                types.String                                                      `tfsdk:"agent_version"`
+	CustomerEncryptionKeyARN    fwtypes.ARN                                                       `tfsdk:"customer_encryption_key_arn"`
+	Description                 types.String                                                      `tfsdk:"description"`
+	FoundationModel             types.String                                                      `tfsdk:"foundation_model"`
+	ID                          types.String                                                      `tfsdk:"id"`
+	IdleSessionTTLInSeconds     types.Int64                                                       `tfsdk:"idle_session_ttl_in_seconds"`
+	Instruction                 types.String                                                      `tfsdk:"instruction"`
+	PrepareAgent                types.Bool                                                        `tfsdk:"prepare_agent"`
+	PromptOverrideConfiguration fwtypes.ListNestedObjectValueOf[promptOverrideConfigurationModel] `tfsdk:"prompt_override_configuration"`
+	SkipResourceInUseCheck      types.Bool                                                        `tfsdk:"skip_resource_in_use_check"`
+	Tags                        types.Map                                                         `tfsdk:"tags"`
+	TagsAll                     types.Map                                                         `tfsdk:"tags_all"`
+	Timeouts                    timeouts.Value                                                    `tfsdk:"timeouts"`
+}
+
+func (data *agentResourceModel) refreshFromOutput(ctx context.Context, output *awstypes.Agent) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Inference parameters are returned as float32.
+	// Flatten them via their shortest decimal representation to avoid spurious differences.
+	diags.Append(fwflex.Flatten(ctx, flattenAgentPromptOverrideConfiguration(output), data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+// agentPromptOverrideConfiguration mirrors the API's PromptOverrideConfiguration with float64 inference parameters.
+type agentPromptOverrideConfiguration struct {
+	PromptOverrideConfiguration *promptOverrideConfiguration
+}
+
+type promptOverrideConfiguration struct {
+	OverrideLambda       *string
+	PromptConfigurations []promptConfiguration
+}
+
+type promptConfiguration struct {
+	BasePromptTemplate     *string
+	InferenceConfiguration *inferenceConfiguration
+	ParserMode             awstypes.CreationMode
+	PromptCreationMode     awstypes.CreationMode
+	PromptState            awstypes.PromptState
+	PromptType             awstypes.PromptType
+}
+
+type inferenceConfiguration struct {
+	MaximumLength *int32
+	StopSequences []string
+	Temperature   *float64
+	TopK          *int32
+	TopP          *float64
+}
+
+func flattenAgentPromptOverrideConfiguration(agent *awstypes.Agent) *agentPromptOverrideConfiguration {
+	apiObject := &agentPromptOverrideConfiguration{}
+
+	v := agent.PromptOverrideConfiguration
+	if v == nil {
+		return apiObject
+	}
+
+	apiObject.PromptOverrideConfiguration = &promptOverrideConfiguration{
+		OverrideLambda: v.OverrideLambda,
+	}
+
+	for _, v := range v.PromptConfigurations {
+		promptConfiguration := promptConfiguration{
+			BasePromptTemplate: v.BasePromptTemplate,
+			ParserMode:         v.ParserMode,
+			PromptCreationMode: v.PromptCreationMode,
+			PromptState:        v.PromptState,
+			PromptType:         v.PromptType,
+		}
+
+		if v := v.InferenceConfiguration; v != nil {
+			promptConfiguration.InferenceConfiguration = &inferenceConfiguration{
+				MaximumLength: v.MaximumLength,
+				StopSequences: v.StopSequences,
+				Temperature:   float32ToFloat64(v.Temperature),
+				TopK:          v.TopK,
+				TopP:          float32ToFloat64(v.TopP),
+			}
+		}
+
+		apiObject.PromptOverrideConfiguration.PromptConfigurations = append(apiObject.PromptOverrideConfiguration.PromptConfigurations, promptConfiguration)
+	}
+
+	return apiObject
+}
+
+// float32ToFloat64 converts a float32 pointer to the float64 with the same shortest decimal representation.
+func float32ToFloat64(v *float32) *float64 {
+	if v == nil {
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(strconv.FormatFloat(float64(aws.ToFloat32(v)), 'g', -1, 32), 64)
+
+	if err != nil {
+		return aws.Float64(float64(aws.ToFloat32(v)))
+	}
+
+	return aws.Float64(f)
+}
+
+type promptOverrideConfigurationModel struct {
+	OverrideLambda       fwtypes.ARN                                              `tfsdk:"override_lambda"`
+	PromptConfigurations fwtypes.SetNestedObjectValueOf[promptConfigurationModel] `tfsdk:"prompt_configurations"`
+}
+
+type promptConfigurationModel struct {
+	BasePromptTemplate     types.String                                                 `tfsdk:"base_prompt_template"`
+	InferenceConfiguration fwtypes.ListNestedObjectValueOf[inferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ParserMode             fwtypes.StringEnum[awstypes.CreationMode]                    `tfsdk:"parser_mode"`
+	PromptCreationMode     fwtypes.StringEnum[awstypes.CreationMode]                    `tfsdk:"prompt_creation_mode"`
+	PromptState            fwtypes.StringEnum[awstypes.PromptState]                     `tfsdk:"prompt_state"`
+	PromptType             fwtypes.StringEnum[awstypes.PromptType]                      `tfsdk:"prompt_type"`
+}
+
+type inferenceConfigurationModel struct {
+	MaximumLength types.Int64                       `tfsdk:"max_length"`
+	StopSequences fwtypes.ListValueOf[types.String] `tfsdk:"stop_sequences"`
+	Temperature   types.Float64                     `tfsdk:"temperature"`
+	TopK          types.Int64                       `tfsdk:"top_k"`
+	TopP          types.Float64                     `tfsdk:"top_p"`
+}
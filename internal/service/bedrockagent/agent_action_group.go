@@ -0,0 +1,520 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Agent Action Group")
+func newAgentActionGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &agentActionGroupResource{}, nil
+}
+
+type agentActionGroupResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *agentActionGroupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_agent_action_group"
+}
+
+func (r *agentActionGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action_group_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"action_group_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"action_group_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ActionGroupState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agent_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(agentVersionDraft),
+				Validators: []validator.String{
+					stringvalidator.OneOf(agentVersionDraft),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"parent_action_group_signature": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ActionGroupSignature](),
+				Optional:   true,
+			},
+			"skip_resource_in_use_check": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"action_group_executor": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[actionGroupExecutorModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"lambda": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"api_schema": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[apiSchemaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"payload": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("payload"),
+									path.MatchRelative().AtParent().AtName("s3"),
+								),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"s3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3IdentifierModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"s3_bucket_name": schema.StringAttribute{
+										Required: true,
+									},
+									"s3_object_key": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *agentActionGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data agentActionGroupResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateAgentActionGroupInput{
+		ActionGroupName:            fwflex.StringFromFramework(ctx, data.ActionGroupName),
+		ActionGroupState:           data.ActionGroupState.ValueEnum(),
+		AgentId:                    fwflex.StringFromFramework(ctx, data.AgentID),
+		AgentVersion:               fwflex.StringFromFramework(ctx, data.AgentVersion),
+		ClientToken:                aws.String(sdkid.UniqueId()),
+		Description:                fwflex.StringFromFramework(ctx, data.Description),
+		ParentActionGroupSignature: data.ParentActionGroupSignature.ValueEnum(),
+	}
+
+	var diags diag.Diagnostics
+	input.ActionGroupExecutor, diags = expandActionGroupExecutor(ctx, data.ActionGroupExecutor)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ApiSchema, diags = expandAPISchema(ctx, data.APISchema)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateAgentActionGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Action Group (%s)", data.ActionGroupName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ActionGroupID = fwflex.StringToFramework(ctx, output.AgentActionGroup.ActionGroupId)
+	data.setID()
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output.AgentActionGroup)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *agentActionGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data agentActionGroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findAgentActionGroupByThreePartKey(ctx, conn, data.ActionGroupID.ValueString(), data.AgentID.ValueString(), data.AgentVersion.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Action Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *agentActionGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new agentActionGroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.ActionGroupExecutor.Equal(old.ActionGroupExecutor) ||
+		!new.ActionGroupName.Equal(old.ActionGroupName) ||
+		!new.ActionGroupState.Equal(old.ActionGroupState) ||
+		!new.APISchema.Equal(old.APISchema) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ParentActionGroupSignature.Equal(old.ParentActionGroupSignature) {
+		input := &bedrockagent.UpdateAgentActionGroupInput{
+			ActionGroupId:              fwflex.StringFromFramework(ctx, new.ActionGroupID),
+			ActionGroupName:            fwflex.StringFromFramework(ctx, new.ActionGroupName),
+			ActionGroupState:           new.ActionGroupState.ValueEnum(),
+			AgentId:                    fwflex.StringFromFramework(ctx, new.AgentID),
+			AgentVersion:               fwflex.StringFromFramework(ctx, new.AgentVersion),
+			Description:                fwflex.StringFromFramework(ctx, new.Description),
+			ParentActionGroupSignature: new.ParentActionGroupSignature.ValueEnum(),
+		}
+
+		var diags diag.Diagnostics
+		input.ActionGroupExecutor, diags = expandActionGroupExecutor(ctx, new.ActionGroupExecutor)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.ApiSchema, diags = expandAPISchema(ctx, new.APISchema)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateAgentActionGroup(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Action Group (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, output.AgentActionGroup)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *agentActionGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data agentActionGroupResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteAgentActionGroup(ctx, &bedrockagent.DeleteAgentActionGroupInput{
+		ActionGroupId:          aws.String(data.ActionGroupID.ValueString()),
+		AgentId:                aws.String(data.AgentID.ValueString()),
+		AgentVersion:           aws.String(data.AgentVersion.ValueString()),
+		SkipResourceInUseCheck: data.SkipResourceInUseCheck.ValueBool(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Action Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findAgentActionGroupByThreePartKey(ctx context.Context, conn *bedrockagent.Client, actionGroupID, agentID, agentVersion string) (*awstypes.AgentActionGroup, error) {
+	input := &bedrockagent.GetAgentActionGroupInput{
+		ActionGroupId: aws.String(actionGroupID),
+		AgentId:       aws.String(agentID),
+		AgentVersion:  aws.String(agentVersion),
+	}
+
+	output, err := conn.GetAgentActionGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AgentActionGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AgentActionGroup, nil
+}
+
+type agentActionGroupResourceModel struct {
+	ActionGroupExecutor        fwtypes.ListNestedObjectValueOf[actionGroupExecutorModel] `tfsdk:"action_group_executor"`
+	ActionGroupID              types.String                                              `tfsdk:"action_group_id"`
+	ActionGroupName            types.String                                              `tfsdk:"action_group_name"`
+	ActionGroupState           fwtypes.StringEnum[awstypes.ActionGroupState]             `tfsdk:"action_group_state"`
+	AgentID                    types.String                                              `tfsdk:"agent_id"`
+	AgentVersion               types.String                                              `tfsdk:"agent_version"`
+	APISchema                  fwtypes.ListNestedObjectValueOf[apiSchemaModel]           `tfsdk:"api_schema"`
+	Description                types.String                                              `tfsdk:"description"`
+	ID                         types.String                                              `tfsdk:"id"`
+	ParentActionGroupSignature fwtypes.StringEnum[awstypes.ActionGroupSignature]         `tfsdk:"parent_action_group_signature"`
+	SkipResourceInUseCheck     types.Bool                                                `tfsdk:"skip_resource_in_use_check"`
+}
+
+const (
+	agentActionGroupResourceIDPartCount = 3
+)
+
+func (data *agentActionGroupResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, agentActionGroupResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ActionGroupID = types.StringValue(parts[0])
+	data.AgentID = types.StringValue(parts[1])
+	data.AgentVersion = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *agentActionGroupResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ActionGroupID.ValueString(), data.AgentID.ValueString(), data.AgentVersion.ValueString()}, agentActionGroupResourceIDPartCount, false)))
+}
+
+func (data *agentActionGroupResourceModel) refreshFromOutput(ctx context.Context, output *awstypes.AgentActionGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API returns the signature in a field with a different name.
+	data.ParentActionGroupSignature = fwtypes.StringEnumNull[awstypes.ActionGroupSignature]()
+	if v := output.ParentActionSignature; v != "" {
+		data.ParentActionGroupSignature = fwtypes.StringEnumValue(v)
+	}
+
+	// Union types are not handled by AutoFlex.
+	data.ActionGroupExecutor = flattenActionGroupExecutor(ctx, output.ActionGroupExecutor)
+	data.APISchema = flattenAPISchema(ctx, output.ApiSchema)
+
+	return diags
+}
+
+type actionGroupExecutorModel struct {
+	Lambda fwtypes.ARN `tfsdk:"lambda"`
+}
+
+type apiSchemaModel struct {
+	Payload types.String                                       `tfsdk:"payload"`
+	S3      fwtypes.ListNestedObjectValueOf[s3IdentifierModel] `tfsdk:"s3"`
+}
+
+type s3IdentifierModel struct {
+	S3BucketName types.String `tfsdk:"s3_bucket_name"`
+	S3ObjectKey  types.String `tfsdk:"s3_object_key"`
+}
+
+func expandActionGroupExecutor(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[actionGroupExecutorModel]) (awstypes.ActionGroupExecutor, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObject, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObject == nil {
+		return nil, diags
+	}
+
+	return &awstypes.ActionGroupExecutorMemberLambda{
+		Value: tfObject.Lambda.ValueString(),
+	}, diags
+}
+
+func flattenActionGroupExecutor(ctx context.Context, apiObject awstypes.ActionGroupExecutor) fwtypes.ListNestedObjectValueOf[actionGroupExecutorModel] {
+	switch v := apiObject.(type) {
+	case *awstypes.ActionGroupExecutorMemberLambda:
+		return fwtypes.NewListNestedObjectValueOfPtr(ctx, &actionGroupExecutorModel{
+			Lambda: fwtypes.ARNValue(v.Value),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfNull[actionGroupExecutorModel](ctx)
+}
+
+func expandAPISchema(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[apiSchemaModel]) (awstypes.APISchema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObject, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObject == nil {
+		return nil, diags
+	}
+
+	if !tfObject.Payload.IsNull() {
+		return &awstypes.APISchemaMemberPayload{
+			Value: tfObject.Payload.ValueString(),
+		}, diags
+	}
+
+	tfS3, d := tfObject.S3.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfS3 == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.APISchemaMemberS3{}
+	diags.Append(fwflex.Expand(ctx, tfS3, &apiObject.Value)...)
+
+	return apiObject, diags
+}
+
+func flattenAPISchema(ctx context.Context, apiObject awstypes.APISchema) fwtypes.ListNestedObjectValueOf[apiSchemaModel] {
+	switch v := apiObject.(type) {
+	case *awstypes.APISchemaMemberPayload:
+		return fwtypes.NewListNestedObjectValueOfPtr(ctx, &apiSchemaModel{
+			Payload: types.StringValue(v.Value),
+			S3:      fwtypes.NewListNestedObjectValueOfNull[s3IdentifierModel](ctx),
+		})
+
+	case *awstypes.APISchemaMemberS3:
+		return fwtypes.NewListNestedObjectValueOfPtr(ctx, &apiSchemaModel{
+			Payload: types.StringNull(),
+			S3: fwtypes.NewListNestedObjectValueOfPtr(ctx, &s3IdentifierModel{
+				S3BucketName: fwflex.StringToFramework(ctx, v.Value.S3BucketName),
+				S3ObjectKey:  fwflex.StringToFramework(ctx, v.Value.S3ObjectKey),
+			}),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfNull[apiSchemaModel](ctx)
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockAgentAgentActionGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_action_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockAgentEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentActionGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentActionGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_group_executor.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "action_group_executor.0.lambda", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "action_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "action_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "action_group_state", "ENABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_id", "aws_bedrockagent_agent.test", "agent_id"),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "api_schema.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_schema.0.payload"),
+					resource.TestCheckResourceAttr(resourceName, "api_schema.0.s3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Basic Agent Action"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_resource_in_use_check"},
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgentActionGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_action_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockAgentEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentActionGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentActionGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrockagent.ResourceAgentActionGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgentActionGroup_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_action_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockAgentEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentActionGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentActionGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_group_state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Basic Agent Action"),
+				),
+			},
+			{
+				Config: testAccAgentActionGroupConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentActionGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_group_state", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated Agent Action"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgentActionGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_agent_action_group" {
+				continue
+			}
+
+			_, err := tfbedrockagent.FindAgentActionGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["action_group_id"], rs.Primary.Attributes["agent_id"], rs.Primary.Attributes["agent_version"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Action Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgentActionGroupExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		_, err := tfbedrockagent.FindAgentActionGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["action_group_id"], rs.Primary.Attributes["agent_id"], rs.Primary.Attributes["agent_version"])
+
+		return err
+	}
+}
+
+func testAccAgentActionGroupConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_base(rName, "anthropic.claude-v2"), fmt.Sprintf(`
+resource "aws_bedrockagent_agent" "test" {
+  agent_name              = %[1]q
+  agent_resource_role_arn = aws_iam_role.test.arn
+  foundation_model        = "anthropic.claude-v2"
+  prepare_agent           = false
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "index.handler"
+  runtime       = "nodejs16.x"
+}
+
+resource "aws_lambda_permission" "test" {
+  action         = "lambda:InvokeFunction"
+  function_name  = aws_lambda_function.test.function_name
+  principal      = "bedrock.amazonaws.com"
+  source_account = data.aws_caller_identity.current.account_id
+  source_arn     = aws_bedrockagent_agent.test.agent_arn
+}
+`, rName))
+}
+
+func testAccAgentActionGroupConfig_apiSchema() string {
+	return `
+locals {
+  api_schema = jsonencode({
+    openapi = "3.0.0"
+    info = {
+      title   = "Weather API"
+      version = "1.0.0"
+    }
+    paths = {
+      "/weather" = {
+        get = {
+          summary     = "Get the current weather"
+          description = "Get the current weather for a city"
+          operationId = "getWeather"
+          parameters = [{
+            name        = "city"
+            in          = "query"
+            description = "Name of the city"
+            required    = true
+            schema = {
+              type = "string"
+            }
+          }]
+          responses = {
+            "200" = {
+              description = "Current weather"
+              content = {
+                "application/json" = {
+                  schema = {
+                    type = "string"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  })
+}
+`
+}
+
+func testAccAgentActionGroupConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAgentActionGroupConfig_base(rName), testAccAgentActionGroupConfig_apiSchema(), fmt.Sprintf(`
+resource "aws_bedrockagent_agent_action_group" "test" {
+  action_group_name          = %[1]q
+  agent_id                   = aws_bedrockagent_agent.test.agent_id
+  agent_version              = "DRAFT"
+  description                = "Basic Agent Action"
+  skip_resource_in_use_check = true
+
+  action_group_executor {
+    lambda = aws_lambda_function.test.arn
+  }
+
+  api_schema {
+    payload = local.api_schema
+  }
+}
+`, rName))
+}
+
+func testAccAgentActionGroupConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccAgentActionGroupConfig_base(rName), testAccAgentActionGroupConfig_apiSchema(), fmt.Sprintf(`
+resource "aws_bedrockagent_agent_action_group" "test" {
+  action_group_name          = %[1]q
+  action_group_state         = "DISABLED"
+  agent_id                   = aws_bedrockagent_agent.test.agent_id
+  agent_version              = "DRAFT"
+  description                = "Updated Agent Action"
+  skip_resource_in_use_check = true
+
+  action_group_executor {
+    lambda = aws_lambda_function.test.arn
+  }
+
+  api_schema {
+    payload = local.api_schema
+  }
+}
+`, rName))
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+// Exports for use in tests only.
+var (
+	ResourceAgent            = newAgentResource
+	ResourceAgentActionGroup = newAgentActionGroupResource
+	ResourceDataSource       = newDataSourceResource
+	ResourceKnowledgeBase    = newKnowledgeBaseResource
+
+	FindAgentActionGroupByThreePartKey = findAgentActionGroupByThreePartKey
+	FindAgentByID                      = findAgentByID
+	FindDataSourceByTwoPartKey         = findDataSourceByTwoPartKey
+	FindKnowledgeBaseByID              = findKnowledgeBaseByID
+)
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The OpenSearch Serverless collection must already contain a vector index named
+// "bedrock-knowledge-base-default-index" with the field mapping used below.
+func TestAccBedrockAgentKnowledgeBase_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	collectionARN := acctest.SkipIfEnvVarNotSet(t, "BEDROCK_OPENSEARCH_SERVERLESS_COLLECTION_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockAgentEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_basic(rName, collectionARN, "test description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttrSet(resourceName, "knowledge_base_arn"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.type", "VECTOR"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_configuration.0.vector_knowledge_base_configuration.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "knowledge_base_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.0.type", "OPENSEARCH_SERVERLESS"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.0.opensearch_serverless_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_configuration.0.opensearch_serverless_configuration.0.collection_arn", collectionARN),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccKnowledgeBaseConfig_basic(rName, collectionARN, "updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentKnowledgeBase_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	collectionARN := acctest.SkipIfEnvVarNotSet(t, "BEDROCK_OPENSEARCH_SERVERLESS_COLLECTION_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockAgentEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_basic(rName, collectionARN, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrockagent.ResourceKnowledgeBase, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentKnowledgeBase_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	collectionARN := acctest.SkipIfEnvVarNotSet(t, "BEDROCK_OPENSEARCH_SERVERLESS_COLLECTION_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockAgentEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKnowledgeBaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig_tags1(rName, collectionARN, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccKnowledgeBaseConfig_tags2(rName, collectionARN, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccKnowledgeBaseConfig_tags1(rName, collectionARN, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKnowledgeBaseDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_knowledge_base" {
+				continue
+			}
+
+			_, err := tfbedrockagent.FindKnowledgeBaseByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Knowledge Base %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKnowledgeBaseExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		_, err := tfbedrockagent.FindKnowledgeBaseByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccKnowledgeBaseConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "bedrock.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "bedrock:InvokeModel"
+      Effect   = "Allow"
+      Resource = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/amazon.titan-embed-text-v1"
+      }, {
+      Action   = "aoss:APIAccessAll"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccKnowledgeBaseConfig_basic(rName, collectionARN, description string) string {
+	return acctest.ConfigCompose(testAccKnowledgeBaseConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_knowledge_base" "test" {
+  name        = %[1]q
+  description = %[3]q
+  role_arn    = aws_iam_role.test.arn
+
+  knowledge_base_configuration {
+    type = "VECTOR"
+
+    vector_knowledge_base_configuration {
+      embedding_model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/amazon.titan-embed-text-v1"
+    }
+  }
+
+  storage_configuration {
+    type = "OPENSEARCH_SERVERLESS"
+
+    opensearch_serverless_configuration {
+      collection_arn    = %[2]q
+      vector_index_name = "bedrock-knowledge-base-default-index"
+
+      field_mapping {
+        metadata_field = "AMAZON_BEDROCK_METADATA"
+        text_field     = "AMAZON_BEDROCK_TEXT_CHUNK"
+        vector_field   = "bedrock-knowledge-base-default-vector"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, collectionARN, description))
+}
+
+func testAccKnowledgeBaseConfig_tags1(rName, collectionARN, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccKnowledgeBaseConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_knowledge_base" "test" {
+  name        = %[1]q
+  description = %[3]q
+  role_arn    = aws_iam_role.test.arn
+
+  knowledge_base_configuration {
+    type = "VECTOR"
+
+    vector_knowledge_base_configuration {
+      embedding_model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/amazon.titan-embed-text-v1"
+    }
+  }
+
+  storage_configuration {
+    type = "OPENSEARCH_SERVERLESS"
+
+    opensearch_serverless_configuration {
+      collection_arn    = %[2]q
+      vector_index_name = "bedrock-knowledge-base-default-index"
+
+      field_mapping {
+        metadata_field = "AMAZON_BEDROCK_METADATA"
+        text_field     = "AMAZON_BEDROCK_TEXT_CHUNK"
+        vector_field   = "bedrock-knowledge-base-default-vector"
+      }
+    }
+  }
+
+  tags = {
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, collectionARN, "test description", tagKey1, tagValue1))
+}
+
+func testAccKnowledgeBaseConfig_tags2(rName, collectionARN, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccKnowledgeBaseConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_knowledge_base" "test" {
+  name        = %[1]q
+  description = %[3]q
+  role_arn    = aws_iam_role.test.arn
+
+  knowledge_base_configuration {
+    type = "VECTOR"
+
+    vector_knowledge_base_configuration {
+      embedding_model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/amazon.titan-embed-text-v1"
+    }
+  }
+
+  storage_configuration {
+    type = "OPENSEARCH_SERVERLESS"
+
+    opensearch_serverless_configuration {
+      collection_arn    = %[2]q
+      vector_index_name = "bedrock-knowledge-base-default-index"
+
+      field_mapping {
+        metadata_field = "AMAZON_BEDROCK_METADATA"
+        text_field     = "AMAZON_BEDROCK_TEXT_CHUNK"
+        vector_field   = "bedrock-knowledge-base-default-vector"
+      }
+    }
+  }
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, collectionARN, "test description", tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -5,7 +5,9 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -36,6 +38,7 @@ func ResourceDistribution() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				// Set non API attributes to their Default settings in the schema
+				d.Set("ignore_external_members", false)
 				d.Set("retain_on_delete", false)
 				d.Set("wait_for_deployment", true)
 				return []*schema.ResourceData{d}, nil
@@ -59,174 +62,7 @@ func ResourceDistribution() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"allowed_methods": {
-							Type:     schema.TypeSet,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"cached_methods": {
-							Type:     schema.TypeSet,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"cache_policy_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"compress": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
-						},
-						"field_level_encryption_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"forwarded_values": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"cookies": {
-										Type:     schema.TypeList,
-										Required: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"forward": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringInSlice(cloudfront.ItemSelection_Values(), false),
-												},
-												"whitelisted_names": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													Elem:     &schema.Schema{Type: schema.TypeString},
-												},
-											},
-										},
-									},
-									"headers": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										Computed: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"query_string": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"query_string_cache_keys": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Computed: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
-						},
-						"lambda_function_association": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							MaxItems: 4,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"event_type": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(cloudfront.EventType_Values(), false),
-									},
-									"lambda_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"include_body": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-								},
-							},
-							Set: LambdaFunctionAssociationHash,
-						},
-						"function_association": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							MaxItems: 2,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"event_type": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(cloudfront.EventType_Values(), false),
-									},
-									"function_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
-						},
-						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
-						},
-						"min_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  0,
-						},
-						"origin_request_policy_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"path_pattern": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"realtime_log_config_arn": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"response_headers_policy_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"smooth_streaming": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"target_origin_id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"trusted_key_groups": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"trusted_signers": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"viewer_protocol_policy": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(cloudfront.ViewerProtocolPolicy_Values(), false),
-						},
-					},
+					Schema: cacheBehaviorSchema(),
 				},
 			},
 			"continuous_deployment_policy_id": {
@@ -452,6 +288,11 @@ func ResourceDistribution() *schema.Resource {
 				Default:      cloudfront.HttpVersionHttp2,
 				ValidateFunc: validation.StringInSlice(cloudfront.HttpVersion_Values(), false),
 			},
+			"ignore_external_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"logging_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -522,130 +363,7 @@ func ResourceDistribution() *schema.Resource {
 				Required: true,
 				Set:      OriginHash,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"connection_attempts": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      3,
-							ValidateFunc: validation.IntBetween(1, 3),
-						},
-						"connection_timeout": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      10,
-							ValidateFunc: validation.IntBetween(1, 10),
-						},
-						"custom_origin_config": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"http_port": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"https_port": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"origin_keepalive_timeout": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      5,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-									"origin_read_timeout": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      30,
-										ValidateFunc: validation.IntBetween(1, 180),
-									},
-									"origin_protocol_policy": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(cloudfront.OriginProtocolPolicy_Values(), false),
-									},
-									"origin_ssl_protocols": {
-										Type:     schema.TypeSet,
-										Required: true,
-										Elem: &schema.Schema{
-											Type:         schema.TypeString,
-											ValidateFunc: validation.StringInSlice(cloudfront.SslProtocol_Values(), false),
-										},
-									},
-								},
-							},
-						},
-						"domain_name": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.NoZeroValues,
-						},
-						"custom_header": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      OriginCustomHeaderHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-						},
-						"origin_access_control_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.NoZeroValues,
-						},
-						"origin_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.NoZeroValues,
-						},
-						"origin_path": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
-						},
-						"origin_shield": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"enabled": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"origin_shield_region": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringMatch(regionRegexp, "must be a valid AWS Region Code"),
-									},
-								},
-							},
-						},
-						"s3_origin_config": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"origin_access_identity": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-						},
-					},
+					Schema: originSchema(),
 				},
 			},
 			"price_class": {
@@ -842,6 +560,308 @@ func ResourceDistribution() *schema.Resource {
 	}
 }
 
+// cacheBehaviorSchema returns the schema of an ordered cache behavior.
+// It is shared by the aws_cloudfront_distribution ordered_cache_behavior block and the aws_cloudfront_distribution_cache_behavior resource.
+func cacheBehaviorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allowed_methods": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"cached_methods": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"cache_policy_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"compress": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"default_ttl": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"field_level_encryption_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"forwarded_values": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cookies": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"forward": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(cloudfront.ItemSelection_Values(), false),
+								},
+								"whitelisted_names": {
+									Type:     schema.TypeSet,
+									Optional: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+							},
+						},
+					},
+					"headers": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"query_string": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"query_string_cache_keys": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"lambda_function_association": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 4,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"event_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(cloudfront.EventType_Values(), false),
+					},
+					"lambda_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"include_body": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+			Set: LambdaFunctionAssociationHash,
+		},
+		"function_association": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 2,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"event_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(cloudfront.EventType_Values(), false),
+					},
+					"function_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+			},
+		},
+		"max_ttl": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"min_ttl": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
+		},
+		"origin_request_policy_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"path_pattern": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"realtime_log_config_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		"response_headers_policy_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"smooth_streaming": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"target_origin_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"trusted_key_groups": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"trusted_signers": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"viewer_protocol_policy": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(cloudfront.ViewerProtocolPolicy_Values(), false),
+		},
+	}
+}
+
+// originSchema returns the schema of an origin.
+// It is shared by the aws_cloudfront_distribution origin block and the aws_cloudfront_distribution_origin resource.
+func originSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_attempts": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      3,
+			ValidateFunc: validation.IntBetween(1, 3),
+		},
+		"connection_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 10),
+		},
+		"custom_origin_config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"http_port": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+					"https_port": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+					"origin_keepalive_timeout": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      5,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"origin_read_timeout": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      30,
+						ValidateFunc: validation.IntBetween(1, 180),
+					},
+					"origin_protocol_policy": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(cloudfront.OriginProtocolPolicy_Values(), false),
+					},
+					"origin_ssl_protocols": {
+						Type:     schema.TypeSet,
+						Required: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(cloudfront.SslProtocol_Values(), false),
+						},
+					},
+				},
+			},
+		},
+		"domain_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"custom_header": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Set:      OriginCustomHeaderHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"origin_access_control_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"origin_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"origin_path": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"origin_shield": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"origin_shield_region": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringMatch(regionRegexp, "must be a valid AWS Region Code"),
+					},
+				},
+			},
+		},
+		"s3_origin_config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"origin_access_identity": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
@@ -895,8 +915,13 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.AppendDiagError(diags, names.CloudFront, create.ErrActionReading, ResNameDistribution, d.Id(), err)
 	}
 
+	distributionConfig := output.Distribution.DistributionConfig
+	if d.Get("ignore_external_members").(bool) {
+		distributionConfig = withoutExternalMembers(distributionConfig, distributionManagedOriginIDs(d.Get("origin")), distributionManagedPathPatterns(d.Get("ordered_cache_behavior")))
+	}
+
 	// Update attributes from DistributionConfig
-	err = flattenDistributionConfig(d, output.Distribution.DistributionConfig)
+	err = flattenDistributionConfig(d, distributionConfig)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution (%s): %s", d.Id(), err)
 	}
@@ -930,6 +955,20 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 			IfMatch:            aws.String(d.Get("etag").(string)),
 		}
 
+		ignoreExternalMembers := d.Get("ignore_external_members").(bool)
+		if ignoreExternalMembers {
+			conns.GlobalMutexKV.Lock(d.Id())
+			defer conns.GlobalMutexKV.Unlock(d.Id())
+
+			etag, err := mergeExternalMembers(ctx, conn, d, input.DistributionConfig)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CloudFront Distribution (%s): %s", d.Id(), err)
+			}
+
+			input.IfMatch = aws.String(etag)
+		}
+
 		// ACM and IAM certificate eventual consistency.
 		// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
 		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, func() (interface{}, error) {
@@ -956,6 +995,18 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 
 			input.IfMatch = getDistributionOutput.ETag
 
+			if ignoreExternalMembers {
+				input.DistributionConfig = expandDistributionConfig(d)
+
+				etag, err := mergeExternalMembers(ctx, conn, d, input.DistributionConfig)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating CloudFront Distribution (%s): %s", d.Id(), err)
+				}
+
+				input.IfMatch = aws.String(etag)
+			}
+
 			_, err = conn.UpdateDistributionWithContext(ctx, input)
 		}
 
@@ -1204,3 +1255,183 @@ func distributionDeployRefreshFunc(ctx context.Context, conn *cloudfront.CloudFr
 		return out.Distribution, aws.StringValue(out.Distribution.Status), nil
 	}
 }
+
+const distributionConfigUpdateTimeout = 5 * time.Minute
+
+// updateDistributionConfig applies update to the current configuration of the specified distribution
+// and submits the result, retrying the read-modify-write cycle when the distribution's ETag has changed concurrently.
+func updateDistributionConfig(ctx context.Context, conn *cloudfront.CloudFront, id string, update func(*cloudfront.DistributionConfig) error) error {
+	conns.GlobalMutexKV.Lock(id)
+	defer conns.GlobalMutexKV.Unlock(id)
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, distributionConfigUpdateTimeout, func() (interface{}, error) {
+		output, err := FindDistributionByID(ctx, conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		distributionConfig := output.Distribution.DistributionConfig
+
+		if err := update(distributionConfig); err != nil {
+			return nil, err
+		}
+
+		input := &cloudfront.UpdateDistributionInput{
+			DistributionConfig: distributionConfig,
+			Id:                 aws.String(id),
+			IfMatch:            output.ETag,
+		}
+
+		return conn.UpdateDistributionWithContext(ctx, input)
+	}, cloudfront.ErrCodePreconditionFailed, cloudfront.ErrCodeInvalidIfMatchVersion)
+
+	return err
+}
+
+// mergeExternalMembers adds the origins and ordered cache behaviors that are managed outside of the
+// aws_cloudfront_distribution resource to the specified configuration and returns the ETag of the
+// distribution configuration that they were read from.
+// Externally managed cache behaviors keep their position relative to the managed ones.
+func mergeExternalMembers(ctx context.Context, conn *cloudfront.CloudFront, d *schema.ResourceData, distributionConfig *cloudfront.DistributionConfig) (string, error) {
+	output, err := FindDistributionByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return "", err
+	}
+
+	o, n := d.GetChange("origin")
+	originIDs := distributionManagedOriginIDs(o)
+	for k := range distributionManagedOriginIDs(n) {
+		originIDs[k] = struct{}{}
+	}
+
+	o, n = d.GetChange("ordered_cache_behavior")
+	pathPatterns := distributionManagedPathPatterns(o)
+	for k := range distributionManagedPathPatterns(n) {
+		pathPatterns[k] = struct{}{}
+	}
+
+	remote := output.Distribution.DistributionConfig
+
+	if remote.Origins != nil {
+		origins := distributionConfig.Origins.Items
+		for _, v := range remote.Origins.Items {
+			if _, ok := originIDs[aws.StringValue(v.Id)]; !ok {
+				origins = append(origins, v)
+			}
+		}
+		distributionConfig.Origins = &cloudfront.Origins{
+			Items:    origins,
+			Quantity: aws.Int64(int64(len(origins))),
+		}
+	}
+
+	if remote.CacheBehaviors != nil {
+		var managed []*cloudfront.CacheBehavior
+		if distributionConfig.CacheBehaviors != nil {
+			managed = distributionConfig.CacheBehaviors.Items
+		}
+
+		var cacheBehaviors []*cloudfront.CacheBehavior
+		i := 0
+		for _, v := range remote.CacheBehaviors.Items {
+			if _, ok := pathPatterns[aws.StringValue(v.PathPattern)]; !ok {
+				cacheBehaviors = append(cacheBehaviors, v)
+				continue
+			}
+
+			if i < len(managed) {
+				cacheBehaviors = append(cacheBehaviors, managed[i])
+				i++
+			}
+		}
+		cacheBehaviors = append(cacheBehaviors, managed[i:]...)
+
+		distributionConfig.CacheBehaviors = &cloudfront.CacheBehaviors{
+			Items:    cacheBehaviors,
+			Quantity: aws.Int64(int64(len(cacheBehaviors))),
+		}
+	}
+
+	return aws.StringValue(output.ETag), nil
+}
+
+// withoutExternalMembers returns a copy of the specified configuration containing only the specified origins and ordered cache behaviors.
+func withoutExternalMembers(distributionConfig *cloudfront.DistributionConfig, originIDs, pathPatterns map[string]struct{}) *cloudfront.DistributionConfig {
+	output := *distributionConfig
+
+	if distributionConfig.Origins != nil {
+		var origins []*cloudfront.Origin
+		for _, v := range distributionConfig.Origins.Items {
+			if _, ok := originIDs[aws.StringValue(v.Id)]; ok {
+				origins = append(origins, v)
+			}
+		}
+		output.Origins = &cloudfront.Origins{
+			Items:    origins,
+			Quantity: aws.Int64(int64(len(origins))),
+		}
+	}
+
+	if distributionConfig.CacheBehaviors != nil {
+		var cacheBehaviors []*cloudfront.CacheBehavior
+		for _, v := range distributionConfig.CacheBehaviors.Items {
+			if _, ok := pathPatterns[aws.StringValue(v.PathPattern)]; ok {
+				cacheBehaviors = append(cacheBehaviors, v)
+			}
+		}
+		output.CacheBehaviors = &cloudfront.CacheBehaviors{
+			Items:    cacheBehaviors,
+			Quantity: aws.Int64(int64(len(cacheBehaviors))),
+		}
+	}
+
+	return &output
+}
+
+func distributionManagedOriginIDs(v interface{}) map[string]struct{} {
+	output := make(map[string]struct{})
+
+	if v, ok := v.(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				output[tfMap["origin_id"].(string)] = struct{}{}
+			}
+		}
+	}
+
+	return output
+}
+
+func distributionManagedPathPatterns(v interface{}) map[string]struct{} {
+	output := make(map[string]struct{})
+
+	if v, ok := v.([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				output[tfMap["path_pattern"].(string)] = struct{}{}
+			}
+		}
+	}
+
+	return output
+}
+
+const distributionMemberIDSeparator = ","
+
+func distributionMemberCreateResourceID(distributionID, key string) string {
+	return strings.Join([]string{distributionID, key}, distributionMemberIDSeparator)
+}
+
+// distributionMemberParseResourceID splits a sub-resource ID on the first separator only,
+// as origin IDs and path patterns may themselves contain the separator.
+func distributionMemberParseResourceID(id string) (string, string, error) {
+	distributionID, key, found := strings.Cut(id, distributionMemberIDSeparator)
+
+	if !found || distributionID == "" || key == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DISTRIBUTION-ID%[2]sKEY", id, distributionMemberIDSeparator)
+	}
+
+	return distributionID, key, nil
+}
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_cloudfront_distribution_cache_behavior", name="Distribution Cache Behavior")
+func ResourceDistributionCacheBehavior() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDistributionCacheBehaviorCreate,
+		ReadWithoutTimeout:   resourceDistributionCacheBehaviorRead,
+		UpdateWithoutTimeout: resourceDistributionCacheBehaviorUpdate,
+		DeleteWithoutTimeout: resourceDistributionCacheBehaviorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_deployment", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: distributionCacheBehaviorSchema(),
+	}
+}
+
+func distributionCacheBehaviorSchema() map[string]*schema.Schema {
+	s := cacheBehaviorSchema()
+
+	s["distribution_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["path_pattern"].ForceNew = true
+	s["position"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+	s["wait_for_deployment"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	return s
+}
+
+func resourceDistributionCacheBehaviorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	distributionID := d.Get("distribution_id").(string)
+	cacheBehavior := expandDistributionCacheBehavior(d)
+	pathPattern := aws.StringValue(cacheBehavior.PathPattern)
+	position := -1
+	if !d.GetRawConfig().GetAttr("position").IsNull() {
+		position = d.Get("position").(int)
+	}
+
+	err := updateDistributionConfig(ctx, conn, distributionID, func(distributionConfig *cloudfront.DistributionConfig) error {
+		if distributionConfig.CacheBehaviors == nil {
+			distributionConfig.CacheBehaviors = &cloudfront.CacheBehaviors{}
+		}
+
+		items := distributionConfig.CacheBehaviors.Items
+		if indexOfCacheBehavior(items, pathPattern) != -1 {
+			return fmt.Errorf("cache behavior (%s) already exists", pathPattern)
+		}
+
+		distributionConfig.CacheBehaviors.Items = insertCacheBehavior(items, cacheBehavior, position)
+		distributionConfig.CacheBehaviors.Quantity = aws.Int64(int64(len(distributionConfig.CacheBehaviors.Items)))
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFront Distribution (%s) Cache Behavior (%s): %s", distributionID, pathPattern, err)
+	}
+
+	d.SetId(distributionMemberCreateResourceID(distributionID, pathPattern))
+
+	if d.Get("wait_for_deployment").(bool) {
+		if err := WaitDistributionDeployed(ctx, conn, distributionID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", distributionID, err)
+		}
+	}
+
+	return append(diags, resourceDistributionCacheBehaviorRead(ctx, d, meta)...)
+}
+
+func resourceDistributionCacheBehaviorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	distributionID, pathPattern, err := distributionMemberParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	cacheBehavior, position, err := findDistributionCacheBehaviorByTwoPartKey(ctx, conn, distributionID, pathPattern)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Distribution Cache Behavior (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution Cache Behavior (%s): %s", d.Id(), err)
+	}
+
+	tfMap := flattenCacheBehavior(cacheBehavior)
+	for k := range cacheBehaviorSchema() {
+		if err := d.Set(k, tfMap[k]); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+		}
+	}
+	d.Set("distribution_id", distributionID)
+	d.Set("position", position)
+
+	return diags
+}
+
+func resourceDistributionCacheBehaviorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	if d.HasChangesExcept("wait_for_deployment") {
+		distributionID := d.Get("distribution_id").(string)
+		cacheBehavior := expandDistributionCacheBehavior(d)
+		pathPattern := aws.StringValue(cacheBehavior.PathPattern)
+		position := -1
+		if d.HasChange("position") && !d.GetRawConfig().GetAttr("position").IsNull() {
+			position = d.Get("position").(int)
+		}
+
+		err := updateDistributionConfig(ctx, conn, distributionID, func(distributionConfig *cloudfront.DistributionConfig) error {
+			if distributionConfig.CacheBehaviors == nil {
+				return fmt.Errorf("cache behavior (%s) not found", pathPattern)
+			}
+
+			items := distributionConfig.CacheBehaviors.Items
+			i := indexOfCacheBehavior(items, pathPattern)
+
+			if i == -1 {
+				return fmt.Errorf("cache behavior (%s) not found", pathPattern)
+			}
+
+			if position == -1 {
+				items[i] = cacheBehavior
+			} else {
+				items = append(items[:i], items[i+1:]...)
+				distributionConfig.CacheBehaviors.Items = insertCacheBehavior(items, cacheBehavior, position)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFront Distribution Cache Behavior (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("wait_for_deployment").(bool) {
+			if err := WaitDistributionDeployed(ctx, conn, distributionID); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", distributionID, err)
+			}
+		}
+	}
+
+	return append(diags, resourceDistributionCacheBehaviorRead(ctx, d, meta)...)
+}
+
+func resourceDistributionCacheBehaviorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	distributionID, pathPattern, err := distributionMemberParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFront Distribution Cache Behavior: %s", d.Id())
+	err = updateDistributionConfig(ctx, conn, distributionID, func(distributionConfig *cloudfront.DistributionConfig) error {
+		if distributionConfig.CacheBehaviors == nil {
+			return &retry.NotFoundError{}
+		}
+
+		items := distributionConfig.CacheBehaviors.Items
+		i := indexOfCacheBehavior(items, pathPattern)
+
+		if i == -1 {
+			return &retry.NotFoundError{}
+		}
+
+		distributionConfig.CacheBehaviors.Items = append(items[:i], items[i+1:]...)
+		distributionConfig.CacheBehaviors.Quantity = aws.Int64(int64(len(distributionConfig.CacheBehaviors.Items)))
+
+		return nil
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Distribution Cache Behavior (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("wait_for_deployment").(bool) {
+		if err := WaitDistributionDeployed(ctx, conn, distributionID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", distributionID, err)
+		}
+	}
+
+	return diags
+}
+
+func findDistributionCacheBehaviorByTwoPartKey(ctx context.Context, conn *cloudfront.CloudFront, distributionID, pathPattern string) (*cloudfront.CacheBehavior, int, error) {
+	output, err := FindDistributionByID(ctx, conn, distributionID)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if v := output.Distribution.DistributionConfig.CacheBehaviors; v != nil {
+		if i := indexOfCacheBehavior(v.Items, pathPattern); i != -1 {
+			return v.Items[i], i, nil
+		}
+	}
+
+	return nil, 0, &retry.NotFoundError{}
+}
+
+func indexOfCacheBehavior(items []*cloudfront.CacheBehavior, pathPattern string) int {
+	for i, v := range items {
+		if aws.StringValue(v.PathPattern) == pathPattern {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// insertCacheBehavior inserts the cache behavior at the specified position.
+// A negative position, or one past the end of the list, appends the cache behavior.
+func insertCacheBehavior(items []*cloudfront.CacheBehavior, cacheBehavior *cloudfront.CacheBehavior, position int) []*cloudfront.CacheBehavior {
+	if position < 0 || position >= len(items) {
+		return append(items, cacheBehavior)
+	}
+
+	items = append(items[:position+1], items[position:]...)
+	items[position] = cacheBehavior
+
+	return items
+}
+
+func expandDistributionCacheBehavior(d *schema.ResourceData) *cloudfront.CacheBehavior {
+	tfMap := make(map[string]interface{})
+	for k := range cacheBehaviorSchema() {
+		tfMap[k] = d.Get(k)
+	}
+
+	return expandCacheBehavior(tfMap)
+}
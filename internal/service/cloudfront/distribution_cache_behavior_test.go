@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudFrontDistributionCacheBehavior_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cacheBehavior cloudfront.CacheBehavior
+	resourceName := "aws_cloudfront_distribution_cache_behavior.test"
+	distributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionCacheBehaviorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionCacheBehaviorConfig_basic("allow-all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionCacheBehaviorExists(ctx, resourceName, &cacheBehavior),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", distributionResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "path_pattern", "/external/*"),
+					resource.TestCheckResourceAttr(resourceName, "position", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_origin_id", "primary"),
+					resource.TestCheckResourceAttr(resourceName, "viewer_protocol_policy", "allow-all"),
+					resource.TestCheckResourceAttr(distributionResourceName, "ordered_cache_behavior.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_deployment"},
+			},
+			{
+				Config: testAccDistributionCacheBehaviorConfig_basic("redirect-to-https"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionCacheBehaviorExists(ctx, resourceName, &cacheBehavior),
+					resource.TestCheckResourceAttr(resourceName, "position", "1"),
+					resource.TestCheckResourceAttr(resourceName, "viewer_protocol_policy", "redirect-to-https"),
+					resource.TestCheckResourceAttr(distributionResourceName, "ordered_cache_behavior.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontDistributionCacheBehavior_position(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cacheBehavior cloudfront.CacheBehavior
+	resourceName := "aws_cloudfront_distribution_cache_behavior.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionCacheBehaviorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionCacheBehaviorConfig_position(0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionCacheBehaviorExists(ctx, resourceName, &cacheBehavior),
+					resource.TestCheckResourceAttr(resourceName, "position", "0"),
+					resource.TestCheckResourceAttr("aws_cloudfront_distribution.test", "ordered_cache_behavior.0.path_pattern", "/managed/*"),
+				),
+			},
+			{
+				Config: testAccDistributionCacheBehaviorConfig_position(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionCacheBehaviorExists(ctx, resourceName, &cacheBehavior),
+					resource.TestCheckResourceAttr(resourceName, "position", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionCacheBehaviorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfront_distribution_cache_behavior" {
+				continue
+			}
+
+			_, _, err := tfcloudfront.FindDistributionCacheBehaviorByTwoPartKey(ctx, conn, rs.Primary.Attributes["distribution_id"], rs.Primary.Attributes["path_pattern"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudFront Distribution Cache Behavior %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDistributionCacheBehaviorExists(ctx context.Context, n string, v *cloudfront.CacheBehavior) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
+
+		output, _, err := tfcloudfront.FindDistributionCacheBehaviorByTwoPartKey(ctx, conn, rs.Primary.Attributes["distribution_id"], rs.Primary.Attributes["path_pattern"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDistributionCacheBehaviorConfig_managed() string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "test" {
+  enabled                 = false
+  ignore_external_members = true
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "primary"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  ordered_cache_behavior {
+    path_pattern     = "/managed/*"
+    allowed_methods  = ["GET", "HEAD"]
+    cached_methods   = ["GET", "HEAD"]
+    target_origin_id = "primary"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+
+    viewer_protocol_policy = "allow-all"
+  }
+
+  default_cache_behavior {
+    allowed_methods  = ["GET", "HEAD"]
+    cached_methods   = ["GET", "HEAD"]
+    target_origin_id = "primary"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+
+    viewer_protocol_policy = "allow-all"
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+
+  %[1]s
+}
+`, testAccDistributionRetainConfig())
+}
+
+func testAccDistributionCacheBehaviorConfig_basic(viewerProtocolPolicy string) string {
+	return acctest.ConfigCompose(testAccDistributionCacheBehaviorConfig_managed(), fmt.Sprintf(`
+resource "aws_cloudfront_distribution_cache_behavior" "test" {
+  distribution_id  = aws_cloudfront_distribution.test.id
+  path_pattern     = "/external/*"
+  allowed_methods  = ["GET", "HEAD"]
+  cached_methods   = ["GET", "HEAD"]
+  target_origin_id = "primary"
+
+  forwarded_values {
+    query_string = false
+
+    cookies {
+      forward = "none"
+    }
+  }
+
+  viewer_protocol_policy = %[1]q
+}
+`, viewerProtocolPolicy))
+}
+
+func testAccDistributionCacheBehaviorConfig_position(position int) string {
+	return acctest.ConfigCompose(testAccDistributionCacheBehaviorConfig_managed(), fmt.Sprintf(`
+resource "aws_cloudfront_distribution_cache_behavior" "test" {
+  distribution_id  = aws_cloudfront_distribution.test.id
+  path_pattern     = "/external/*"
+  position         = %[1]d
+  allowed_methods  = ["GET", "HEAD"]
+  cached_methods   = ["GET", "HEAD"]
+  target_origin_id = "primary"
+
+  forwarded_values {
+    query_string = false
+
+    cookies {
+      forward = "none"
+    }
+  }
+
+  viewer_protocol_policy = "allow-all"
+}
+`, position))
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_cloudfront_distribution_origin", name="Distribution Origin")
+func ResourceDistributionOrigin() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDistributionOriginCreate,
+		ReadWithoutTimeout:   resourceDistributionOriginRead,
+		UpdateWithoutTimeout: resourceDistributionOriginUpdate,
+		DeleteWithoutTimeout: resourceDistributionOriginDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_deployment", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: distributionOriginSchema(),
+	}
+}
+
+func distributionOriginSchema() map[string]*schema.Schema {
+	s := originSchema()
+
+	s["distribution_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["origin_id"].ForceNew = true
+	s["wait_for_deployment"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	return s
+}
+
+func resourceDistributionOriginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	distributionID := d.Get("distribution_id").(string)
+	origin := expandDistributionOrigin(d)
+	originID := aws.StringValue(origin.Id)
+
+	err := updateDistributionConfig(ctx, conn, distributionID, func(distributionConfig *cloudfront.DistributionConfig) error {
+		if findOrigin(distributionConfig, originID) != nil {
+			return fmt.Errorf("origin (%s) already exists", originID)
+		}
+
+		distributionConfig.Origins.Items = append(distributionConfig.Origins.Items, origin)
+		distributionConfig.Origins.Quantity = aws.Int64(int64(len(distributionConfig.Origins.Items)))
+
+		return nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFront Distribution (%s) Origin (%s): %s", distributionID, originID, err)
+	}
+
+	d.SetId(distributionMemberCreateResourceID(distributionID, originID))
+
+	if d.Get("wait_for_deployment").(bool) {
+		if err := WaitDistributionDeployed(ctx, conn, distributionID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", distributionID, err)
+		}
+	}
+
+	return append(diags, resourceDistributionOriginRead(ctx, d, meta)...)
+}
+
+func resourceDistributionOriginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	distributionID, originID, err := distributionMemberParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	origin, err := findDistributionOriginByTwoPartKey(ctx, conn, distributionID, originID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Distribution Origin (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution Origin (%s): %s", d.Id(), err)
+	}
+
+	tfMap := FlattenOrigin(origin)
+	for k := range originSchema() {
+		if err := d.Set(k, tfMap[k]); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+		}
+	}
+	d.Set("distribution_id", distributionID)
+
+	return diags
+}
+
+func resourceDistributionOriginUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	if d.HasChangesExcept("wait_for_deployment") {
+		distributionID := d.Get("distribution_id").(string)
+		origin := expandDistributionOrigin(d)
+		originID := aws.StringValue(origin.Id)
+
+		err := updateDistributionConfig(ctx, conn, distributionID, func(distributionConfig *cloudfront.DistributionConfig) error {
+			for i, v := range distributionConfig.Origins.Items {
+				if aws.StringValue(v.Id) == originID {
+					distributionConfig.Origins.Items[i] = origin
+
+					return nil
+				}
+			}
+
+			return fmt.Errorf("origin (%s) not found", originID)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFront Distribution Origin (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("wait_for_deployment").(bool) {
+			if err := WaitDistributionDeployed(ctx, conn, distributionID); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", distributionID, err)
+			}
+		}
+	}
+
+	return append(diags, resourceDistributionOriginRead(ctx, d, meta)...)
+}
+
+func resourceDistributionOriginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	distributionID, originID, err := distributionMemberParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFront Distribution Origin: %s", d.Id())
+	err = updateDistributionConfig(ctx, conn, distributionID, func(distributionConfig *cloudfront.DistributionConfig) error {
+		for i, v := range distributionConfig.Origins.Items {
+			if aws.StringValue(v.Id) == originID {
+				distributionConfig.Origins.Items = append(distributionConfig.Origins.Items[:i], distributionConfig.Origins.Items[i+1:]...)
+				distributionConfig.Origins.Quantity = aws.Int64(int64(len(distributionConfig.Origins.Items)))
+
+				return nil
+			}
+		}
+
+		return &retry.NotFoundError{}
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Distribution Origin (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("wait_for_deployment").(bool) {
+		if err := WaitDistributionDeployed(ctx, conn, distributionID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", distributionID, err)
+		}
+	}
+
+	return diags
+}
+
+func findDistributionOriginByTwoPartKey(ctx context.Context, conn *cloudfront.CloudFront, distributionID, originID string) (*cloudfront.Origin, error) {
+	output, err := FindDistributionByID(ctx, conn, distributionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	origin := findOrigin(output.Distribution.DistributionConfig, originID)
+
+	if origin == nil {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return origin, nil
+}
+
+func findOrigin(distributionConfig *cloudfront.DistributionConfig, originID string) *cloudfront.Origin {
+	if distributionConfig.Origins == nil {
+		return nil
+	}
+
+	for _, v := range distributionConfig.Origins.Items {
+		if aws.StringValue(v.Id) == originID {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func expandDistributionOrigin(d *schema.ResourceData) *cloudfront.Origin {
+	tfMap := make(map[string]interface{})
+	for k := range originSchema() {
+		tfMap[k] = d.Get(k)
+	}
+
+	return ExpandOrigin(tfMap)
+}
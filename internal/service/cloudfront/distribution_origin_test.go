@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudFrontDistributionOrigin_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var origin cloudfront.Origin
+	resourceName := "aws_cloudfront_distribution_origin.test"
+	distributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionOriginConfig_basic("www.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionOriginExists(ctx, resourceName, &origin),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", distributionResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", "www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "origin_id", "external"),
+					resource.TestCheckResourceAttr(resourceName, "custom_origin_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_origin_config.0.origin_protocol_policy", "https-only"),
+					resource.TestCheckResourceAttr(distributionResourceName, "origin.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_deployment"},
+			},
+			{
+				Config: testAccDistributionOriginConfig_basic("www.example.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionOriginExists(ctx, resourceName, &origin),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", "www.example.org"),
+					resource.TestCheckResourceAttr(distributionResourceName, "origin.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontDistributionOrigin_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var origin cloudfront.Origin
+	resourceName := "aws_cloudfront_distribution_origin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionOriginConfig_basic("www.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionOriginExists(ctx, resourceName, &origin),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudfront.ResourceDistributionOrigin(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionOriginDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfront_distribution_origin" {
+				continue
+			}
+
+			_, err := tfcloudfront.FindDistributionOriginByTwoPartKey(ctx, conn, rs.Primary.Attributes["distribution_id"], rs.Primary.Attributes["origin_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudFront Distribution Origin %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDistributionOriginExists(ctx context.Context, n string, v *cloudfront.Origin) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
+
+		output, err := tfcloudfront.FindDistributionOriginByTwoPartKey(ctx, conn, rs.Primary.Attributes["distribution_id"], rs.Primary.Attributes["origin_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccDistributionMemberConfig_base returns a distribution that ignores externally managed origins and cache behaviors.
+func testAccDistributionMemberConfig_base() string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "test" {
+  enabled                 = false
+  ignore_external_members = true
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "primary"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  default_cache_behavior {
+    allowed_methods  = ["GET", "HEAD"]
+    cached_methods   = ["GET", "HEAD"]
+    target_origin_id = "primary"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+
+    viewer_protocol_policy = "allow-all"
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+
+  %[1]s
+}
+`, testAccDistributionRetainConfig())
+}
+
+func testAccDistributionOriginConfig_basic(domainName string) string {
+	return acctest.ConfigCompose(testAccDistributionMemberConfig_base(), fmt.Sprintf(`
+resource "aws_cloudfront_distribution_origin" "test" {
+  distribution_id = aws_cloudfront_distribution.test.id
+  domain_name     = %[1]q
+  origin_id       = "external"
+
+  custom_origin_config {
+    http_port              = 80
+    https_port             = 443
+    origin_protocol_policy = "https-only"
+    origin_ssl_protocols   = ["TLSv1.2"]
+  }
+}
+`, domainName))
+}
@@ -7,5 +7,7 @@ package cloudfront
 var (
 	ResourceContinuousDeploymentPolicy = newResourceContinuousDeploymentPolicy
 
-	FindPublicKeyByID = findPublicKeyByID
+	FindDistributionCacheBehaviorByTwoPartKey = findDistributionCacheBehaviorByTwoPartKey
+	FindDistributionOriginByTwoPartKey        = findDistributionOriginByTwoPartKey
+	FindPublicKeyByID                         = findPublicKeyByID
 )
@@ -83,6 +83,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceDistributionCacheBehavior,
+			TypeName: "aws_cloudfront_distribution_cache_behavior",
+			Name:     "Distribution Cache Behavior",
+		},
+		{
+			Factory:  ResourceDistributionOrigin,
+			TypeName: "aws_cloudfront_distribution_origin",
+			Name:     "Distribution Origin",
+		},
 		{
 			Factory:  ResourceFieldLevelEncryptionConfig,
 			TypeName: "aws_cloudfront_field_level_encryption_config",
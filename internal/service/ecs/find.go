@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -157,3 +158,38 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+// findActiveTaskDefinitionARNsByFamily returns the ARNs of all ACTIVE revisions of a task definition family, newest first.
+func findActiveTaskDefinitionARNsByFamily(ctx context.Context, conn *ecs.ECS, family string) ([]string, error) {
+	input := &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(family),
+		Sort:         aws.String(ecs.SortOrderDesc),
+		Status:       aws.String(ecs.TaskDefinitionStatusActive),
+	}
+	var output []string
+
+	err := conn.ListTaskDefinitionsPagesWithContext(ctx, input, func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TaskDefinitionArns {
+			arn := aws.StringValue(v)
+
+			// FamilyPrefix also matches families sharing the prefix.
+			if !strings.HasSuffix(StripRevision(arn), "task-definition/"+family) {
+				continue
+			}
+
+			output = append(output, arn)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
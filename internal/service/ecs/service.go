@@ -467,6 +467,11 @@ func ResourceService() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"wait_for_steady_state_max_task_failures": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -616,11 +621,13 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.StringValue(output.Service.ServiceArn))
 
-	fn := waitServiceActive
 	if d.Get("wait_for_steady_state").(bool) {
-		fn = waitServiceStable
-	}
-	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		_, warnings, err := waitServiceStable(ctx, conn, d.Id(), d.Get("cluster").(string), d.Get("wait_for_steady_state_max_task_failures").(int), d.Timeout(schema.TimeoutCreate))
+		diags = append(diags, warnings...)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
+		}
+	} else if _, err := waitServiceActive(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
 	}
 
@@ -939,11 +946,13 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s): %s", d.Id(), err)
 		}
 
-		fn := waitServiceActive
 		if d.Get("wait_for_steady_state").(bool) {
-			fn = waitServiceStable
-		}
-		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			_, warnings, err := waitServiceStable(ctx, conn, d.Id(), d.Get("cluster").(string), d.Get("wait_for_steady_state_max_task_failures").(int), d.Timeout(schema.TimeoutUpdate))
+			diags = append(diags, warnings...)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
+			}
+		} else if _, err := waitServiceActive(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
 		}
 	}
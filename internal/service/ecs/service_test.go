@@ -1061,6 +1061,25 @@ func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 	})
 }
 
+func TestAccECSService_LaunchTypeFargate_waitForSteadyStateMaxTaskFailures(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The task exits immediately, so the deployment never reaches a steady state.
+				Config:      testAccServiceConfig_launchTypeFargateAndWaitMaxTaskFailures(rName, 2),
+				ExpectError: regexache.MustCompile(`has \d+ failed tasks, reaching the configured threshold of 2`),
+			},
+		},
+	})
+}
+
 func TestAccECSService_LaunchTypeFargate_updateWaitForSteadyState(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -1865,6 +1884,46 @@ resource "aws_ecs_service" "test" {
 `, rName, desiredCount, waitForSteadyState))
 }
 
+func testAccServiceConfig_launchTypeFargateAndWaitMaxTaskFailures(rName string, maxTaskFailures int) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "crash" {
+  family                   = "%[1]s-crash"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "command": ["false"],
+    "essential": true,
+    "image": "busybox:latest",
+    "name": "crash"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.crash.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    security_groups  = [aws_security_group.test[0].id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  wait_for_steady_state                   = true
+  wait_for_steady_state_max_task_failures = %[2]d
+}
+`, rName, maxTaskFailures))
+}
+
 func testAccServiceConfig_interchangeablePlacementStrategy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
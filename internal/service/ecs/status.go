@@ -5,10 +5,16 @@ package ecs
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -20,6 +26,8 @@ const (
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
@@ -96,6 +104,190 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 	}
 }
 
+// serviceDeploymentTracker records the progress of an ECS Service's primary deployment across refreshes
+// so that service events and stopped tasks are reported once and a circuit breaker rollback can be detected.
+type serviceDeploymentTracker struct {
+	deploymentID    string
+	maxTaskFailures int
+	progress        string
+	seenEvents      map[string]struct{}
+	seenTasks       map[string]struct{}
+	since           time.Time
+	warnings        diag.Diagnostics
+}
+
+func newServiceDeploymentTracker(maxTaskFailures int) *serviceDeploymentTracker {
+	return &serviceDeploymentTracker{
+		maxTaskFailures: maxTaskFailures,
+		seenEvents:      make(map[string]struct{}),
+		seenTasks:       make(map[string]struct{}),
+		since:           time.Now(),
+	}
+}
+
+func statusServiceDeployment(ctx context.Context, conn *ecs.ECS, id, cluster string, tracker *serviceDeploymentTracker) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		serviceRaw, status, err := statusServiceWaitForStable(ctx, conn, id, cluster)()
+		if err != nil {
+			return nil, "", err
+		}
+
+		service, ok := serviceRaw.(*ecs.Service)
+		if !ok || service == nil {
+			return serviceRaw, status, nil
+		}
+
+		tracker.reportEvents(service)
+
+		primary := primaryServiceDeployment(service)
+		if primary == nil {
+			return service, status, nil
+		}
+
+		if tracker.deploymentID == "" {
+			tracker.deploymentID = aws.StringValue(primary.Id)
+		}
+
+		tracker.progress = fmt.Sprintf("deployment (%s) rollout state %s: %d running, %d pending, %d failed of %d desired",
+			aws.StringValue(primary.Id), aws.StringValue(primary.RolloutState), aws.Int64Value(primary.RunningCount),
+			aws.Int64Value(primary.PendingCount), aws.Int64Value(primary.FailedTasks), aws.Int64Value(primary.DesiredCount))
+		log.Printf("[DEBUG] ECS Service (%s) %s", id, tracker.progress)
+
+		if err := tracker.reportStoppedTasks(ctx, conn, service, primary); err != nil {
+			return nil, "", err
+		}
+
+		// The deployment circuit breaker rolls back by replacing the failed deployment
+		// with a new primary deployment of the last completed task definition.
+		if deploymentID := aws.StringValue(primary.Id); deploymentID != tracker.deploymentID {
+			reason := "superseded"
+			for _, v := range service.Deployments {
+				if aws.StringValue(v.Id) == tracker.deploymentID && aws.StringValue(v.RolloutStateReason) != "" {
+					reason = aws.StringValue(v.RolloutStateReason)
+				}
+			}
+
+			return nil, "", fmt.Errorf("deployment (%s) rolled back to deployment (%s) of task definition (%s): %s",
+				tracker.deploymentID, deploymentID, aws.StringValue(primary.TaskDefinition), reason)
+		}
+
+		if aws.StringValue(primary.RolloutState) == ecs.DeploymentRolloutStateFailed {
+			return nil, "", fmt.Errorf("deployment (%s) failed: %s", tracker.deploymentID, aws.StringValue(primary.RolloutStateReason))
+		}
+
+		if n := aws.Int64Value(primary.FailedTasks); tracker.maxTaskFailures > 0 && n >= int64(tracker.maxTaskFailures) {
+			return nil, "", fmt.Errorf("deployment (%s) has %d failed tasks, reaching the configured threshold of %d", tracker.deploymentID, n, tracker.maxTaskFailures)
+		}
+
+		return service, status, nil
+	}
+}
+
+func (t *serviceDeploymentTracker) reportEvents(service *ecs.Service) {
+	// Events are returned newest first.
+	for i := len(service.Events) - 1; i >= 0; i-- {
+		event := service.Events[i]
+		eventID := aws.StringValue(event.Id)
+
+		if _, ok := t.seenEvents[eventID]; ok {
+			continue
+		}
+		t.seenEvents[eventID] = struct{}{}
+
+		if aws.TimeValue(event.CreatedAt).Before(t.since) {
+			continue
+		}
+
+		t.warnings = sdkdiag.AppendWarningf(t.warnings, "ECS Service (%s) event: %s", aws.StringValue(service.ServiceName), aws.StringValue(event.Message))
+	}
+}
+
+func (t *serviceDeploymentTracker) reportStoppedTasks(ctx context.Context, conn *ecs.ECS, service *ecs.Service, deployment *ecs.Deployment) error {
+	if aws.Int64Value(deployment.FailedTasks) == 0 {
+		return nil
+	}
+
+	input := &ecs.ListTasksInput{
+		Cluster:       service.ClusterArn,
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		ServiceName:   service.ServiceName,
+	}
+	var taskARNs []*string
+
+	err := conn.ListTasksPagesWithContext(ctx, input, func(page *ecs.ListTasksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TaskArns {
+			if _, ok := t.seenTasks[aws.StringValue(v)]; !ok {
+				taskARNs = append(taskARNs, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("listing stopped tasks: %w", err)
+	}
+
+	// DescribeTasks accepts at most 100 tasks per call.
+	for len(taskARNs) > 0 {
+		n := len(taskARNs)
+		if n > 100 {
+			n = 100
+		}
+		chunk := taskARNs[:n]
+		taskARNs = taskARNs[n:]
+
+		output, err := conn.DescribeTasksWithContext(ctx, &ecs.DescribeTasksInput{
+			Cluster: service.ClusterArn,
+			Tasks:   chunk,
+		})
+
+		if err != nil {
+			return fmt.Errorf("describing stopped tasks: %w", err)
+		}
+
+		for _, task := range output.Tasks {
+			taskARN := aws.StringValue(task.TaskArn)
+			t.seenTasks[taskARN] = struct{}{}
+
+			if aws.StringValue(task.TaskDefinitionArn) != aws.StringValue(deployment.TaskDefinition) || aws.TimeValue(task.StoppedAt).Before(t.since) {
+				continue
+			}
+
+			var reasons []string
+			for _, container := range task.Containers {
+				if container.ExitCode == nil && aws.StringValue(container.Reason) == "" {
+					continue
+				}
+
+				reason := fmt.Sprintf("%s exited with code %d", aws.StringValue(container.Name), aws.Int64Value(container.ExitCode))
+				if v := aws.StringValue(container.Reason); v != "" {
+					reason += ": " + v
+				}
+				reasons = append(reasons, reason)
+			}
+
+			t.warnings = sdkdiag.AppendWarningf(t.warnings, "ECS Task (%s) stopped: %s (%s)", taskARN, aws.StringValue(task.StoppedReason), strings.Join(reasons, "; "))
+		}
+	}
+
+	return nil
+}
+
+func primaryServiceDeployment(service *ecs.Service) *ecs.Deployment {
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			return v
+		}
+	}
+
+	return nil
+}
+
 func stabilityStatusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, cluster string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &ecs.DescribeTaskSetsInput{
@@ -74,10 +74,13 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container_definition": taskDefinitionContainerDefinitionSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v interface{}) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -151,6 +154,12 @@ func ResourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
+			"keep_revisions": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"skip_destroy"},
+			},
 			"ipc_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -264,9 +273,10 @@ func ResourceTaskDefinition() *schema.Resource {
 				},
 			},
 			"skip_destroy": {
-				Type:     schema.TypeBool,
-				Default:  false,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Default:       false,
+				Optional:      true,
+				ConflictsWith: []string{"keep_revisions"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -449,10 +459,15 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+	var definitions []*ecs.ContainerDefinition
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		definitions = expandTaskDefinitionContainerDefinitions(v.([]interface{}))
+	} else {
+		var err error
+		definitions, err = expandContainerDefinitions(d.Get("container_definitions").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+		}
 	}
 
 	input := &ecs.RegisterTaskDefinitionInput{
@@ -541,6 +556,13 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	d.Set("arn", taskDefinition.TaskDefinitionArn)
 	d.Set("arn_without_revision", StripRevision(aws.StringValue(taskDefinition.TaskDefinitionArn)))
 
+	// Retain the new revision plus the configured number of previous revisions.
+	if v, ok := d.GetOk("keep_revisions"); ok {
+		if err := deregisterTaskDefinitionRevisions(ctx, conn, d.Id(), v.(int)+1); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Id(), err)
+		}
+	}
+
 	// For partitions not supporting tag-on-create, attempt tag after create.
 	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
 		err := createTags(ctx, conn, aws.StringValue(taskDefinition.TaskDefinitionArn), tags)
@@ -612,6 +634,14 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
 	}
 
+	// Only populate the typed container definitions when they are in use, otherwise
+	// every JSON-configured task definition would show a diff.
+	if _, ok := d.GetOk("container_definition"); ok {
+		if err := d.Set("container_definition", flattenTaskDefinitionContainerDefinitions(taskDefinition.ContainerDefinitions)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting container_definition: %s", err)
+		}
+	}
+
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set("execution_role_arn", taskDefinition.ExecutionRoleArn)
 	d.Set("cpu", taskDefinition.Cpu)
@@ -656,7 +686,17 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 func resourceTaskDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	// Tags only.
+	// Tags and keep_revisions only.
+
+	if d.HasChange("keep_revisions") {
+		if v, ok := d.GetOk("keep_revisions"); ok {
+			conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+			if err := deregisterTaskDefinitionRevisions(ctx, conn, d.Id(), v.(int)+1); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ECS Task Definition (%s): %s", d.Id(), err)
+			}
+		}
+	}
 
 	return append(diags, resourceTaskDefinitionRead(ctx, d, meta)...)
 }
@@ -670,6 +710,16 @@ func resourceTaskDefinitionDelete(ctx context.Context, d *schema.ResourceData, m
 
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
+	// The revision being destroyed becomes the newest of the retained previous revisions.
+	if v, ok := d.GetOk("keep_revisions"); ok {
+		log.Printf("[DEBUG] Retaining ECS Task Definition Revision %q", d.Id())
+		if err := deregisterTaskDefinitionRevisions(ctx, conn, d.Id(), v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting ECS Task Definition (%s): %s", d.Id(), err)
+		}
+
+		return diags
+	}
+
 	_, err := conn.DeregisterTaskDefinitionWithContext(ctx, &ecs.DeregisterTaskDefinitionInput{
 		TaskDefinition: aws.String(d.Get("arn").(string)),
 	})
@@ -680,6 +730,33 @@ func resourceTaskDefinitionDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// deregisterTaskDefinitionRevisions deregisters all ACTIVE revisions of the specified family
+// except the most recent keep revisions.
+func deregisterTaskDefinitionRevisions(ctx context.Context, conn *ecs.ECS, family string, keep int) error {
+	arns, err := findActiveTaskDefinitionARNsByFamily(ctx, conn, family)
+
+	if err != nil {
+		return fmt.Errorf("listing revisions: %w", err)
+	}
+
+	if len(arns) <= keep {
+		return nil
+	}
+
+	for _, arn := range arns[keep:] {
+		log.Printf("[DEBUG] Deregistering ECS Task Definition Revision %q", arn)
+		_, err := conn.DeregisterTaskDefinitionWithContext(ctx, &ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: aws.String(arn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deregistering revision (%s): %w", arn, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceTaskDefinitionVolumeHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -0,0 +1,704 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// taskDefinitionContainerDefinitionSchema returns the typed alternative to the
+// JSON-encoded container_definitions argument. Every attribute forces a new
+// revision, as task definitions are immutable.
+func taskDefinitionContainerDefinitionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"container_definition", "container_definitions"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"depends_on": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"docker_labels": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				"health_check": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"interval": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(2, 120),
+							},
+						},
+					},
+				},
+				"hostname": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"image": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"links": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"memory": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"memory_reservation": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"mount_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+								Default:  false,
+							},
+							"source_volume": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+					),
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.TransportProtocolTcp,
+								ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+							},
+						},
+					},
+				},
+				"privileged": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"readonly_root_filesystem": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"repository_credentials_parameter": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"secrets": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"start_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"stop_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(0, 120),
+				},
+				"ulimit": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hard_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.UlimitName_Values(), false),
+							},
+							"soft_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func expandTaskDefinitionContainerDefinitions(tfList []interface{}) []*ecs.ContainerDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]*ecs.ContainerDefinition, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandTaskDefinitionContainerDefinition(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerDefinition(tfMap map[string]interface{}) *ecs.ContainerDefinition {
+	apiObject := &ecs.ContainerDefinition{
+		Essential: aws.Bool(tfMap["essential"].(bool)),
+		Image:     aws.String(tfMap["image"].(string)),
+		Name:      aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok && v > 0 {
+		apiObject.Cpu = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.DependsOn = append(apiObject.DependsOn, &ecs.ContainerDependency{
+				Condition:     aws.String(tfMap["condition"].(string)),
+				ContainerName: aws.String(tfMap["container_name"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.DockerLabels = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EntryPoint = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		for name, value := range v {
+			apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+				Name:  aws.String(name),
+				Value: aws.String(value.(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HealthCheck = expandTaskDefinitionContainerDefinitionHealthCheck(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["hostname"].(string); ok && v != "" {
+		apiObject.Hostname = aws.String(v)
+	}
+
+	if v, ok := tfMap["links"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Links = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandTaskDefinitionContainerDefinitionLogConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v > 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v > 0 {
+		apiObject.MemoryReservation = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.MountPoints = append(apiObject.MountPoints, &ecs.MountPoint{
+				ContainerPath: aws.String(tfMap["container_path"].(string)),
+				ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+				SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PortMappings = expandTaskDefinitionContainerDefinitionPortMappings(v)
+	}
+
+	if v, ok := tfMap["privileged"].(bool); ok && v {
+		apiObject.Privileged = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+		apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["repository_credentials_parameter"].(string); ok && v != "" {
+		apiObject.RepositoryCredentials = &ecs.RepositoryCredentials{
+			CredentialsParameter: aws.String(v),
+		}
+	}
+
+	if v, ok := tfMap["secrets"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Secrets = expandTaskDefinitionContainerDefinitionSecrets(v)
+	}
+
+	if v, ok := tfMap["start_timeout"].(int); ok && v > 0 {
+		apiObject.StartTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stop_timeout"].(int); ok && v > 0 {
+		apiObject.StopTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["ulimit"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Ulimits = append(apiObject.Ulimits, &ecs.Ulimit{
+				HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+				Name:      aws.String(tfMap["name"].(string)),
+				SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+			})
+		}
+	}
+
+	if v, ok := tfMap["user"].(string); ok && v != "" {
+		apiObject.User = aws.String(v)
+	}
+
+	if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+		apiObject.WorkingDirectory = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTaskDefinitionContainerDefinitionHealthCheck(tfMap map[string]interface{}) *ecs.HealthCheck {
+	apiObject := &ecs.HealthCheck{
+		Command: flex.ExpandStringList(tfMap["command"].([]interface{})),
+	}
+
+	if v, ok := tfMap["interval"].(int); ok && v > 0 {
+		apiObject.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["retries"].(int); ok && v > 0 {
+		apiObject.Retries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v > 0 {
+		apiObject.StartPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout"].(int); ok && v > 0 {
+		apiObject.Timeout = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandTaskDefinitionContainerDefinitionLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.SecretOptions = expandTaskDefinitionContainerDefinitionSecrets(v)
+	}
+
+	return apiObject
+}
+
+func expandTaskDefinitionContainerDefinitionPortMappings(tfList []interface{}) []*ecs.PortMapping {
+	var apiObjects []*ecs.PortMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.PortMapping{
+			ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+			Protocol:      aws.String(tfMap["protocol"].(string)),
+		}
+
+		if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+			apiObject.AppProtocol = aws.String(v)
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v > 0 {
+			apiObject.HostPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerDefinitionSecrets(tfMap map[string]interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for name, valueFrom := range tfMap {
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(name),
+			ValueFrom: aws.String(valueFrom.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenTaskDefinitionContainerDefinitions(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTaskDefinitionContainerDefinition(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainerDefinition(apiObject *ecs.ContainerDefinition) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"command":                  aws.StringValueSlice(apiObject.Command),
+		"cpu":                      aws.Int64Value(apiObject.Cpu),
+		"docker_labels":            aws.StringValueMap(apiObject.DockerLabels),
+		"entry_point":              aws.StringValueSlice(apiObject.EntryPoint),
+		"essential":                aws.BoolValue(apiObject.Essential),
+		"hostname":                 aws.StringValue(apiObject.Hostname),
+		"image":                    aws.StringValue(apiObject.Image),
+		"links":                    aws.StringValueSlice(apiObject.Links),
+		"memory":                   aws.Int64Value(apiObject.Memory),
+		"memory_reservation":       aws.Int64Value(apiObject.MemoryReservation),
+		"name":                     aws.StringValue(apiObject.Name),
+		"privileged":               aws.BoolValue(apiObject.Privileged),
+		"readonly_root_filesystem": aws.BoolValue(apiObject.ReadonlyRootFilesystem),
+		"start_timeout":            aws.Int64Value(apiObject.StartTimeout),
+		"stop_timeout":             aws.Int64Value(apiObject.StopTimeout),
+		"user":                     aws.StringValue(apiObject.User),
+		"working_directory":        aws.StringValue(apiObject.WorkingDirectory),
+	}
+
+	var dependsOn []interface{}
+	for _, v := range apiObject.DependsOn {
+		dependsOn = append(dependsOn, map[string]interface{}{
+			"condition":      aws.StringValue(v.Condition),
+			"container_name": aws.StringValue(v.ContainerName),
+		})
+	}
+	tfMap["depends_on"] = dependsOn
+
+	environment := make(map[string]interface{}, len(apiObject.Environment))
+	for _, v := range apiObject.Environment {
+		environment[aws.StringValue(v.Name)] = aws.StringValue(v.Value)
+	}
+	tfMap["environment"] = environment
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap["health_check"] = []interface{}{map[string]interface{}{
+			"command":      aws.StringValueSlice(v.Command),
+			"interval":     aws.Int64Value(v.Interval),
+			"retries":      aws.Int64Value(v.Retries),
+			"start_period": aws.Int64Value(v.StartPeriod),
+			"timeout":      aws.Int64Value(v.Timeout),
+		}}
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMap["log_configuration"] = []interface{}{map[string]interface{}{
+			"log_driver":     aws.StringValue(v.LogDriver),
+			"options":        aws.StringValueMap(v.Options),
+			"secret_options": flattenTaskDefinitionContainerDefinitionSecrets(v.SecretOptions),
+		}}
+	}
+
+	var mountPoints []interface{}
+	for _, v := range apiObject.MountPoints {
+		mountPoints = append(mountPoints, map[string]interface{}{
+			"container_path": aws.StringValue(v.ContainerPath),
+			"read_only":      aws.BoolValue(v.ReadOnly),
+			"source_volume":  aws.StringValue(v.SourceVolume),
+		})
+	}
+	tfMap["mount_point"] = mountPoints
+
+	var portMappings []interface{}
+	for _, v := range apiObject.PortMappings {
+		portMappings = append(portMappings, map[string]interface{}{
+			"app_protocol":   aws.StringValue(v.AppProtocol),
+			"container_port": aws.Int64Value(v.ContainerPort),
+			"host_port":      aws.Int64Value(v.HostPort),
+			"name":           aws.StringValue(v.Name),
+			"protocol":       aws.StringValue(v.Protocol),
+		})
+	}
+	tfMap["port_mapping"] = portMappings
+
+	if v := apiObject.RepositoryCredentials; v != nil {
+		tfMap["repository_credentials_parameter"] = aws.StringValue(v.CredentialsParameter)
+	}
+
+	tfMap["secrets"] = flattenTaskDefinitionContainerDefinitionSecrets(apiObject.Secrets)
+
+	var ulimits []interface{}
+	for _, v := range apiObject.Ulimits {
+		ulimits = append(ulimits, map[string]interface{}{
+			"hard_limit": aws.Int64Value(v.HardLimit),
+			"name":       aws.StringValue(v.Name),
+			"soft_limit": aws.Int64Value(v.SoftLimit),
+		})
+	}
+	tfMap["ulimit"] = ulimits
+
+	return tfMap
+}
+
+func flattenTaskDefinitionContainerDefinitionSecrets(apiObjects []*ecs.Secret) map[string]interface{} {
+	tfMap := make(map[string]interface{}, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap[aws.StringValue(apiObject.Name)] = aws.StringValue(apiObject.ValueFrom)
+	}
+
+	return tfMap
+}
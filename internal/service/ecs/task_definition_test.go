@@ -1137,6 +1137,83 @@ func TestAccECSTaskDefinition_invalidContainerDefinition(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_containerDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinition(rName, "VARVAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "jenkins"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.VARNAME", "VARVAL"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "mongodb"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.depends_on.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "container_definitions", regexache.MustCompile(`"name":"jenkins"`)),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinition(rName, "VARVAL2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.VARNAME", "VARVAL2"),
+					resource.TestMatchResourceAttr(resourceName, "container_definitions", regexache.MustCompile(`"value":"VARVAL2"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_keepRevisions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def1, def2, def3 ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_keepRevisions(rName, "VARVAL1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def1),
+					resource.TestCheckResourceAttr(resourceName, "keep_revisions", "1"),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_keepRevisions(rName, "VARVAL2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def2),
+					testAccCheckTaskDefinitionRevisionStatus(ctx, &def1, ecs.TaskDefinitionStatusActive),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_keepRevisions(rName, "VARVAL3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def3),
+					testAccCheckTaskDefinitionRevisionStatus(ctx, &def1, ecs.TaskDefinitionStatusInactive),
+					testAccCheckTaskDefinitionRevisionStatus(ctx, &def2, ecs.TaskDefinitionStatusActive),
+				),
+			},
+		},
+	})
+}
+
 func testAccTaskDefinitionConfig_proxyConfiguration(rName string, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) string {
@@ -1325,6 +1402,26 @@ func testAccCheckTaskDefinitionExists(ctx context.Context, name string, def *ecs
 	}
 }
 
+func testAccCheckTaskDefinitionRevisionStatus(ctx context.Context, def *ecs.TaskDefinition, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
+
+		out, err := conn.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+			TaskDefinition: def.TaskDefinitionArn,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(out.TaskDefinition.Status); got != want {
+			return fmt.Errorf("ECS Task Definition (%s) status is %s, want %s", aws.StringValue(def.TaskDefinitionArn), got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTaskDefinitionDockerVolumeConfigurationAutoprovisionNil(def *ecs.TaskDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(def.Volumes) != 1 {
@@ -2868,3 +2965,73 @@ TASK_DEFINITION
 }
 `, rName)
 }
+
+func testAccTaskDefinitionConfig_containerDefinition(rName, envValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name        = "jenkins"
+    image       = "jenkins"
+    cpu         = 10
+    memory      = 128
+    command     = ["sleep", "10"]
+    entry_point = ["/"]
+    links       = ["mongodb"]
+
+    environment = {
+      VARNAME = %[2]q
+    }
+
+    port_mapping {
+      container_port = 80
+      host_port      = 8080
+    }
+
+    mount_point {
+      container_path = "/var/jenkins_home"
+      source_volume  = "jenkins-home"
+    }
+  }
+
+  container_definition {
+    name    = "mongodb"
+    image   = "mongodb"
+    cpu     = 10
+    memory  = 128
+    command = ["sleep", "10"]
+
+    port_mapping {
+      container_port = 28017
+      host_port      = 28017
+    }
+  }
+
+  volume {
+    name      = "jenkins-home"
+    host_path = "/ecs/jenkins-home"
+  }
+}
+`, rName, envValue)
+}
+
+func testAccTaskDefinitionConfig_keepRevisions(rName, envValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family         = %[1]q
+  keep_revisions = 1
+
+  container_definition {
+    name    = "sleep"
+    image   = "busybox"
+    memory  = 128
+    command = ["sleep", "10"]
+
+    environment = {
+      VARNAME = %[2]q
+    }
+  }
+}
+`, rName, envValue)
+}
@@ -5,11 +5,14 @@ package ecs
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -66,29 +69,28 @@ func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string)
 }
 
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running. Does not return tags.
-func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
-	}
-
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
-	}
-
+// Service events and stopped task reasons seen while waiting are returned as warnings. A failed or rolled back primary deployment,
+// or a primary deployment with at least maxTaskFailures failed tasks (if non-zero), is returned as an error.
+func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, maxTaskFailures int, timeout time.Duration) (*ecs.Service, diag.Diagnostics, error) {
+	tracker := newServiceDeploymentTracker(maxTaskFailures)
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster),
+		Refresh: statusServiceDeployment(ctx, conn, id, cluster, tracker),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
+	if tfresource.TimedOut(err) && tracker.progress != "" {
+		tfresource.SetLastError(err, errors.New(tracker.progress))
+	}
+
 	if v, ok := outputRaw.(*ecs.Service); ok {
-		return v, err
+		return v, tracker.warnings, err
 	}
 
-	return nil, err
+	return nil, tracker.warnings, err
 }
 
 // waitServiceInactive waits for an ECS Service to reach the status "INACTIVE".
@@ -728,7 +728,7 @@ func expandInitialCapacityConfig(tfMap map[string]interface{}) types.InitialCapa
 	apiObject := types.InitialCapacityConfig{}
 
 	if v, ok := tfMap["worker_count"].(int); ok {
-		apiObject.WorkerCount = int64(v)
+		apiObject.WorkerCount = aws.Int64(int64(v))
 	}
 
 	if v, ok := tfMap["worker_configuration"].([]interface{}); ok && v[0] != nil {
@@ -744,7 +744,7 @@ func flattenInitialCapacityConfig(apiObject *types.InitialCapacityConfig) map[st
 	}
 
 	tfMap := map[string]interface{}{
-		"worker_count": apiObject.WorkerCount,
+		"worker_count": aws.ToInt64(apiObject.WorkerCount),
 	}
 
 	if v := apiObject.WorkerConfiguration; v != nil {
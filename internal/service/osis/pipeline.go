@@ -0,0 +1,668 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package osis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/osis"
+	"github.com/aws/aws-sdk-go-v2/service/osis/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_osis_pipeline", name="Pipeline")
+// @Tags(identifierAttribute="arn")
+func resourcePipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineCreate,
+		ReadWithoutTimeout:   resourcePipelineRead,
+		UpdateWithoutTimeout: resourcePipelineUpdate,
+		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"buffer_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"persistent_buffer_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"encryption_at_rest_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"ingest_endpoint_urls": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"log_publishing_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_log_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 512),
+											validation.StringMatch(regexache.MustCompile(`^\/aws\/vendedlogs\/[\.\-_/#A-Za-z0-9]+`), "must start with /aws/vendedlogs/"),
+										),
+									},
+								},
+							},
+						},
+						"is_logging_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"max_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"pipeline_configuration_body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringLenBetween(1, 24000),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+			},
+			"pipeline_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 28),
+					validation.StringMatch(regexache.MustCompile(`^[a-z][0-9a-z-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				),
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(types.PipelineStatusActive, types.PipelineStatusStopped), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 12,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 12,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionClient(ctx)
+
+	name := d.Get("pipeline_name").(string)
+	input := &osis.CreatePipelineInput{
+		MaxUnits:                  aws.Int32(int32(d.Get("max_units").(int))),
+		MinUnits:                  aws.Int32(int32(d.Get("min_units").(int))),
+		PipelineConfigurationBody: aws.String(d.Get("pipeline_configuration_body").(string)),
+		PipelineName:              aws.String(name),
+		Tags:                      getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("buffer_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BufferOptions = expandBufferOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("encryption_at_rest_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionAtRestOptions = expandEncryptionAtRestOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("log_publishing_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogPublishingOptions = expandLogPublishingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcOptions = expandVPCOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	// IAM role propagation.
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.ValidationException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreatePipeline(ctx, input)
+	}, "is not authorized")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Ingestion Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitPipelineCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Ingestion Pipeline (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("state"); ok && v.(string) == string(types.PipelineStatusStopped) {
+		if err := stopPipeline(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionClient(ctx)
+
+	pipeline, err := findPipelineByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Ingestion Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Ingestion Pipeline (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", pipeline.PipelineArn)
+	if pipeline.BufferOptions != nil {
+		if err := d.Set("buffer_options", []interface{}{flattenBufferOptions(pipeline.BufferOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting buffer_options: %s", err)
+		}
+	} else {
+		d.Set("buffer_options", nil)
+	}
+	if pipeline.EncryptionAtRestOptions != nil {
+		if err := d.Set("encryption_at_rest_options", []interface{}{flattenEncryptionAtRestOptions(pipeline.EncryptionAtRestOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting encryption_at_rest_options: %s", err)
+		}
+	} else {
+		d.Set("encryption_at_rest_options", nil)
+	}
+	d.Set("ingest_endpoint_urls", pipeline.IngestEndpointUrls)
+	if pipeline.LogPublishingOptions != nil {
+		if err := d.Set("log_publishing_options", []interface{}{flattenLogPublishingOptions(pipeline.LogPublishingOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting log_publishing_options: %s", err)
+		}
+	} else {
+		d.Set("log_publishing_options", nil)
+	}
+	d.Set("max_units", pipeline.MaxUnits)
+	d.Set("min_units", pipeline.MinUnits)
+	d.Set("pipeline_configuration_body", pipeline.PipelineConfigurationBody)
+	d.Set("pipeline_name", pipeline.PipelineName)
+	d.Set("state", pipeline.Status)
+	if len(pipeline.VpcEndpoints) > 0 && pipeline.VpcEndpoints[0].VpcOptions != nil {
+		if err := d.Set("vpc_options", []interface{}{flattenVPCOptions(pipeline.VpcEndpoints[0].VpcOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting vpc_options: %s", err)
+		}
+	} else {
+		d.Set("vpc_options", nil)
+	}
+
+	return diags
+}
+
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionClient(ctx)
+
+	if d.HasChangesExcept("state", "tags", "tags_all") {
+		input := &osis.UpdatePipelineInput{
+			PipelineName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("buffer_options") {
+			if v, ok := d.GetOk("buffer_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.BufferOptions = expandBufferOptions(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.BufferOptions = &types.BufferOptions{
+					PersistentBufferEnabled: aws.Bool(false),
+				}
+			}
+		}
+
+		if d.HasChange("encryption_at_rest_options") {
+			if v, ok := d.GetOk("encryption_at_rest_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.EncryptionAtRestOptions = expandEncryptionAtRestOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("log_publishing_options") {
+			if v, ok := d.GetOk("log_publishing_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LogPublishingOptions = expandLogPublishingOptions(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.LogPublishingOptions = &types.LogPublishingOptions{
+					IsLoggingEnabled: aws.Bool(false),
+				}
+			}
+		}
+
+		if d.HasChange("max_units") {
+			input.MaxUnits = aws.Int32(int32(d.Get("max_units").(int)))
+		}
+
+		if d.HasChange("min_units") {
+			input.MinUnits = aws.Int32(int32(d.Get("min_units").(int)))
+		}
+
+		if d.HasChange("pipeline_configuration_body") {
+			input.PipelineConfigurationBody = aws.String(d.Get("pipeline_configuration_body").(string))
+		}
+
+		_, err := conn.UpdatePipeline(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Ingestion Pipeline (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPipelineUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Ingestion Pipeline (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("state") {
+		switch types.PipelineStatus(d.Get("state").(string)) {
+		case types.PipelineStatusActive:
+			if err := startPipeline(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		case types.PipelineStatusStopped:
+			if err := stopPipeline(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionClient(ctx)
+
+	log.Printf("[INFO] Deleting OpenSearch Ingestion Pipeline: %s", d.Id())
+	_, err := conn.DeletePipeline(ctx, &osis.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Ingestion Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPipelineDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Ingestion Pipeline (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
+
+func startPipeline(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) error {
+	_, err := conn.StartPipeline(ctx, &osis.StartPipelineInput{
+		PipelineName: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting OpenSearch Ingestion Pipeline (%s): %w", name, err)
+	}
+
+	if _, err := waitPipelineStarted(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("waiting for OpenSearch Ingestion Pipeline (%s) start: %w", name, err)
+	}
+
+	return nil
+}
+
+func stopPipeline(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) error {
+	_, err := conn.StopPipeline(ctx, &osis.StopPipelineInput{
+		PipelineName: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping OpenSearch Ingestion Pipeline (%s): %w", name, err)
+	}
+
+	if _, err := waitPipelineStopped(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("waiting for OpenSearch Ingestion Pipeline (%s) stop: %w", name, err)
+	}
+
+	return nil
+}
+
+func findPipelineByName(ctx context.Context, conn *osis.Client, name string) (*types.Pipeline, error) {
+	input := &osis.GetPipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.GetPipeline(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+func statusPipeline(ctx context.Context, conn *osis.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPipelineByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPipeline(ctx context.Context, conn *osis.Client, name string, pending, target []types.PipelineStatus, timeout time.Duration) (*types.Pipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(pending...),
+		Target:     enum.Slice(target...),
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipelineCreated(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*types.Pipeline, error) {
+	return waitPipeline(ctx, conn, name, []types.PipelineStatus{types.PipelineStatusCreating}, []types.PipelineStatus{types.PipelineStatusActive}, timeout)
+}
+
+func waitPipelineUpdated(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*types.Pipeline, error) {
+	return waitPipeline(ctx, conn, name, []types.PipelineStatus{types.PipelineStatusUpdating}, []types.PipelineStatus{types.PipelineStatusActive, types.PipelineStatusStopped}, timeout)
+}
+
+func waitPipelineStarted(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*types.Pipeline, error) {
+	return waitPipeline(ctx, conn, name, []types.PipelineStatus{types.PipelineStatusStarting, types.PipelineStatusStopped}, []types.PipelineStatus{types.PipelineStatusActive}, timeout)
+}
+
+func waitPipelineStopped(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*types.Pipeline, error) {
+	return waitPipeline(ctx, conn, name, []types.PipelineStatus{types.PipelineStatusStopping, types.PipelineStatusActive}, []types.PipelineStatus{types.PipelineStatusStopped}, timeout)
+}
+
+func waitPipelineDeleted(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*types.Pipeline, error) {
+	return waitPipeline(ctx, conn, name, []types.PipelineStatus{types.PipelineStatusDeleting}, []types.PipelineStatus{}, timeout)
+}
+
+func expandBufferOptions(tfMap map[string]interface{}) *types.BufferOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.BufferOptions{}
+
+	if v, ok := tfMap["persistent_buffer_enabled"].(bool); ok {
+		apiObject.PersistentBufferEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandEncryptionAtRestOptions(tfMap map[string]interface{}) *types.EncryptionAtRestOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.EncryptionAtRestOptions{}
+
+	if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+		apiObject.KmsKeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLogPublishingOptions(tfMap map[string]interface{}) *types.LogPublishingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LogPublishingOptions{}
+
+	if v, ok := tfMap["cloudwatch_log_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLogDestination = expandCloudWatchLogDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["is_logging_enabled"].(bool); ok {
+		apiObject.IsLoggingEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandCloudWatchLogDestination(tfMap map[string]interface{}) *types.CloudWatchLogDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CloudWatchLogDestination{}
+
+	if v, ok := tfMap["log_group"].(string); ok && v != "" {
+		apiObject.LogGroup = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandVPCOptions(tfMap map[string]interface{}) *types.VpcOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.VpcOptions{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenBufferOptions(apiObject *types.BufferOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PersistentBufferEnabled; v != nil {
+		tfMap["persistent_buffer_enabled"] = aws.ToBool(v)
+	}
+
+	return tfMap
+}
+
+func flattenEncryptionAtRestOptions(apiObject *types.EncryptionAtRestOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsKeyArn; v != nil {
+		tfMap["kms_key_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenLogPublishingOptions(apiObject *types.LogPublishingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLogDestination; v != nil {
+		tfMap["cloudwatch_log_destination"] = []interface{}{flattenCloudWatchLogDestination(v)}
+	}
+
+	if v := apiObject.IsLoggingEnabled; v != nil {
+		tfMap["is_logging_enabled"] = aws.ToBool(v)
+	}
+
+	return tfMap
+}
+
+func flattenCloudWatchLogDestination(apiObject *types.CloudWatchLogDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LogGroup; v != nil {
+		tfMap["log_group"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenVPCOptions(apiObject *types.VpcOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SecurityGroupIds; v != nil {
+		tfMap["security_group_ids"] = v
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfMap["subnet_ids"] = v
+	}
+
+	return tfMap
+}
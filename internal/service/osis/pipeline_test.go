@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package osis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfosis "github.com/hashicorp/terraform-provider-aws/internal/service/osis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchIngestionPipeline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccPipelineName()
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "osis", regexache.MustCompile(`pipeline/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "ingest_endpoint_urls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_units", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "pipeline_configuration_body"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccPipelineName()
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfosis.ResourcePipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccPipelineName()
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccPipelineName()
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.#", "0"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "buffer_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "buffer_options.0.persistent_buffer_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.0.is_logging_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_options.0.cloudwatch_log_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_publishing_options.0.cloudwatch_log_destination.0.log_group", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "max_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_units", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_state(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccPipelineName()
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_state(rName, "STOPPED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_state(rName, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_vpc(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccPipelineName()
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_vpc(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_options.0.subnet_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPipelineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchIngestionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_osis_pipeline" {
+				continue
+			}
+
+			_, err := tfosis.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("OpenSearch Ingestion Pipeline %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPipelineExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchIngestionClient(ctx)
+
+		_, err := tfosis.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+// testAccPipelineName returns a pipeline name that satisfies the service's 28 character limit.
+func testAccPipelineName() string {
+	return fmt.Sprintf("tf-acc-%s", sdkacctest.RandStringFromCharSet(16, sdkacctest.CharSetAlpha))
+}
+
+func testAccPipelineConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "osis-pipelines.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:PutObject"]
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPipelineConfigurationBody() string {
+	return `
+version: "2"
+log-pipeline:
+  source:
+    http:
+      path: "/test/logs"
+  sink:
+    - s3:
+        aws:
+          sts_role_arn: "${aws_iam_role.test.arn}"
+          region: "${data.aws_region.current.name}"
+        bucket: "${aws_s3_bucket.test.bucket}"
+        threshold:
+          event_collect_timeout: "60s"
+        codec:
+          ndjson:
+`
+}
+
+func testAccPipelineConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+%[2]s
+EOT
+
+  max_units = 1
+  min_units = 1
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccPipelineConfigurationBody()))
+}
+
+func testAccPipelineConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = "/aws/vendedlogs/OpenSearchIngestion/%[1]s"
+}
+
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+%[2]s
+EOT
+
+  max_units = 2
+  min_units = 2
+
+  buffer_options {
+    persistent_buffer_enabled = true
+  }
+
+  log_publishing_options {
+    is_logging_enabled = true
+
+    cloudwatch_log_destination {
+      log_group = aws_cloudwatch_log_group.test.name
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccPipelineConfigurationBody()))
+}
+
+func testAccPipelineConfig_state(rName, state string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+%[2]s
+EOT
+
+  max_units = 1
+  min_units = 1
+  state     = %[3]q
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccPipelineConfigurationBody(), state))
+}
+
+func testAccPipelineConfig_vpc(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+%[2]s
+EOT
+
+  max_units = 1
+  min_units = 1
+
+  vpc_options {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccPipelineConfigurationBody()))
+}
+
+func testAccPipelineConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+%[2]s
+EOT
+
+  max_units = 1
+  min_units = 1
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccPipelineConfigurationBody(), tagKey1, tagValue1))
+}
+
+func testAccPipelineConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+%[2]s
+EOT
+
+  max_units = 1
+  min_units = 1
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccPipelineConfigurationBody(), tagKey1, tagValue1, tagKey2, tagValue2))
+}
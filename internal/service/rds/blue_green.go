@@ -64,9 +64,18 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 }
 
 func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+	return o.switchoverWithTimeout(ctx, identifier, 0, timeout)
+}
+
+// switchoverWithTimeout switches over the Blue/Green Deployment. A non-zero switchoverTimeout overrides the
+// service default for how long the switchover may take before it is rolled back.
+func (o *blueGreenOrchestrator) switchoverWithTimeout(ctx context.Context, identifier string, switchoverTimeout, timeout time.Duration) (*types.BlueGreenDeployment, error) {
 	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
 	}
+	if switchoverTimeout > 0 {
+		input.SwitchoverTimeout = aws.Int32(int32(switchoverTimeout.Seconds()))
+	}
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func() (interface{}, error) {
 			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
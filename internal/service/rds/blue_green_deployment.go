@@ -0,0 +1,469 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	blueGreenDeploymentStatusSwitchoverCompleted = "SWITCHOVER_COMPLETED"
+)
+
+// @SDKResource("aws_rds_blue_green_deployment", name="Blue/Green Deployment")
+// @Tags(identifierAttribute="arn")
+func ResourceBlueGreenDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBlueGreenDeploymentCreate,
+		ReadWithoutTimeout:   resourceBlueGreenDeploymentRead,
+		UpdateWithoutTimeout: resourceBlueGreenDeploymentUpdate,
+		DeleteWithoutTimeout: resourceBlueGreenDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: blueGreenDeploymentSchema(map[string]*schema.Schema{
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_db_instance_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"target_db_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"target_endpoint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"upgrade_target_storage_config": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		}),
+
+		CustomizeDiff: customdiff.Sequence(
+			blueGreenDeploymentSwitchoverCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+// blueGreenDeploymentSchema returns the attributes shared by the DB instance and DB cluster Blue/Green Deployment resources
+// merged with the variant specific attributes.
+func blueGreenDeploymentSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	m := map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"blue_green_deployment_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 60),
+				validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z-]*$`), "must contain only alphanumeric characters and hyphens, and must begin with an alphanumeric character"),
+			),
+		},
+		"delete_target": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status_details": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"switchover": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"switchover_details": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"source_member": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"target_member": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"switchover_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntBetween(30, 3600),
+		},
+		names.AttrTags:    tftags.TagsSchema(),
+		names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		"target": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"target_engine_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+	}
+
+	for k, v := range s {
+		m[k] = v
+	}
+
+	return m
+}
+
+func resourceBlueGreenDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Get("blue_green_deployment_name").(string)),
+		Source:                  aws.String(d.Get("source").(string)),
+		Tags:                    blueGreenDeploymentTags(getTagsIn(ctx)),
+	}
+
+	if v, ok := d.GetOk("target_db_instance_class"); ok {
+		input.TargetDBInstanceClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_db_parameter_group_name"); ok {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_engine_version"); ok {
+		input.TargetEngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("upgrade_target_storage_config"); ok {
+		input.UpgradeTargetStorageConfig = aws.Bool(v.(bool))
+	}
+
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutCreate))
+
+	dep, err := blueGreenDeploymentCreate(ctx, d, conn, input, deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Blue/Green Deployment (%s): %s", d.Get("blue_green_deployment_name").(string), err)
+	}
+
+	targetARN, err := parseDBInstanceARN(aws.StringValue(dep.Target))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Blue/Green Deployment (%s): waiting for Green environment: %s", d.Id(), err)
+	}
+
+	if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, targetARN.Identifier, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Blue/Green Deployment (%s): waiting for Green environment: %s", d.Id(), err)
+	}
+
+	if d.Get("switchover").(bool) {
+		if _, err := blueGreenDeploymentSwitchover(ctx, d, conn, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceBlueGreenDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	dep, err := findBlueGreenDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Blue/Green Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	if err := blueGreenDeploymentSetResourceData(ctx, d, meta, dep); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("target_endpoint", nil)
+	if targetARN, err := parseDBInstanceARN(aws.StringValue(dep.Target)); err == nil {
+		target, err := findDBInstanceByIDSDKv2(ctx, conn, targetARN.Identifier)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading RDS Blue/Green Deployment (%s) target: %s", d.Id(), err)
+		default:
+			d.Set("target_db_instance_class", target.DBInstanceClass)
+			if len(target.DBParameterGroups) > 0 {
+				d.Set("target_db_parameter_group_name", target.DBParameterGroups[0].DBParameterGroupName)
+			}
+			if v := target.Endpoint; v != nil {
+				if err := d.Set("target_endpoint", []interface{}{map[string]interface{}{
+					"address":        aws.StringValue(v.Address),
+					"hosted_zone_id": aws.StringValue(v.HostedZoneId),
+					"port":           aws.Int32Value(v.Port),
+				}}); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting target_endpoint: %s", err)
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+func resourceBlueGreenDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+
+	if d.HasChanges("target_db_instance_class", "target_db_parameter_group_name") {
+		dep, err := findBlueGreenDeploymentByID(ctx, conn, d.Id())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+
+		targetARN, err := parseDBInstanceARN(aws.StringValue(dep.Target))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+
+		input := &rds_sdkv2.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceIdentifier: aws.String(targetARN.Identifier),
+		}
+
+		if d.HasChange("target_db_instance_class") {
+			input.DBInstanceClass = aws.String(d.Get("target_db_instance_class").(string))
+		}
+
+		if d.HasChange("target_db_parameter_group_name") {
+			input.DBParameterGroupName = aws.String(d.Get("target_db_parameter_group_name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating RDS Blue/Green Deployment (%s): Updating Green environment", d.Id())
+
+		if err := dbInstanceModify(ctx, conn, targetARN.Identifier, input, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Blue/Green Deployment (%s): updating Green environment: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("switchover") && d.Get("switchover").(bool) {
+		if _, err := blueGreenDeploymentSwitchover(ctx, d, conn, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceBlueGreenDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return blueGreenDeploymentDelete(ctx, d, meta)
+}
+
+// blueGreenDeploymentCreate creates the Blue/Green Deployment and waits for it to become available.
+func blueGreenDeploymentCreate(ctx context.Context, d *schema.ResourceData, conn *rds_sdkv2.Client, input *rds_sdkv2.CreateBlueGreenDeploymentInput, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+	orchestrator := newBlueGreenOrchestrator(conn)
+
+	dep, err := orchestrator.createDeployment(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(aws.StringValue(dep.BlueGreenDeploymentIdentifier))
+
+	return orchestrator.waitForDeploymentAvailable(ctx, d.Id(), timeout)
+}
+
+func blueGreenDeploymentSetResourceData(ctx context.Context, d *schema.ResourceData, meta interface{}, dep *types.BlueGreenDeployment) error {
+	status := aws.StringValue(dep.Status)
+
+	sourceARN, err := arn.Parse(aws.StringValue(dep.Source))
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", blueGreenDeploymentARN(meta.(*conns.AWSClient), sourceARN, d.Id()))
+	d.Set("blue_green_deployment_name", dep.BlueGreenDeploymentName)
+	d.Set("status", status)
+	d.Set("status_details", dep.StatusDetails)
+	d.Set("switchover", status == blueGreenDeploymentStatusSwitchoverCompleted)
+	d.Set("target", dep.Target)
+
+	// Once switched over, the source is renamed and the original identifier refers to the new environment.
+	if status != blueGreenDeploymentStatusSwitchoverCompleted {
+		d.Set("source", dep.Source)
+	}
+
+	tfList := make([]interface{}, 0, len(dep.SwitchoverDetails))
+	for _, v := range dep.SwitchoverDetails {
+		tfList = append(tfList, map[string]interface{}{
+			"source_member": aws.StringValue(v.SourceMember),
+			"status":        aws.StringValue(v.Status),
+			"target_member": aws.StringValue(v.TargetMember),
+		})
+	}
+	if err := d.Set("switchover_details", tfList); err != nil {
+		return fmt.Errorf("setting switchover_details: %w", err)
+	}
+
+	tags := make([]*rds.Tag, 0, len(dep.TagList))
+	for _, v := range dep.TagList {
+		tags = append(tags, &rds.Tag{
+			Key:   v.Key,
+			Value: v.Value,
+		})
+	}
+	setTagsOut(ctx, tags)
+
+	return nil
+}
+
+func blueGreenDeploymentSwitchover(ctx context.Context, d *schema.ResourceData, conn *rds_sdkv2.Client, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+	log.Printf("[DEBUG] Switching over RDS Blue/Green Deployment (%s)", d.Id())
+
+	switchoverTimeout := time.Duration(d.Get("switchover_timeout").(int)) * time.Second
+
+	return newBlueGreenOrchestrator(conn).switchoverWithTimeout(ctx, d.Id(), switchoverTimeout, timeout)
+}
+
+func blueGreenDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(d.Id()),
+	}
+
+	// The Green environment can only be deleted if switchover has not happened.
+	if d.Get("delete_target").(bool) && d.Get("status").(string) != blueGreenDeploymentStatusSwitchoverCompleted {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", d.Id())
+	_, err := conn.DeleteBlueGreenDeployment(ctx, input)
+
+	if errs.IsA[*types.BlueGreenDeploymentNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete), tfresource.WithDelay(0)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Blue/Green Deployment (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func blueGreenDeploymentSwitchoverCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if o, n := d.GetChange("switchover"); o.(bool) && !n.(bool) {
+		return errors.New("a Blue/Green Deployment that has been switched over cannot be reverted")
+	}
+
+	return nil
+}
+
+func blueGreenDeploymentARN(client *conns.AWSClient, source arn.ARN, id string) string {
+	return arn.ARN{
+		Partition: source.Partition,
+		Service:   rds.ServiceName,
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  "deployment:" + id,
+	}.String()
+}
+
+func blueGreenDeploymentTags(tags []*rds.Tag) []types.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.Tag, 0, len(tags))
+	for _, v := range tags {
+		apiObjects = append(apiObjects, types.Tag{
+			Key:   v.Key,
+			Value: v.Value,
+		})
+	}
+
+	return apiObjects
+}
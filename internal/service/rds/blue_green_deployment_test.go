@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSBlueGreenDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexache.MustCompile(`deployment:bgd-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "delete_target", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "source", "aws_db_instance.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "switchover", "false"),
+					resource.TestCheckResourceAttr(resourceName, "switchover_timeout", "300"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "target"),
+					resource.TestCheckResourceAttr(resourceName, "target_endpoint.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_target", "switchover_timeout"},
+			},
+		},
+	})
+}
+
+func TestAccRDSBlueGreenDeployment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrds.ResourceBlueGreenDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSBlueGreenDeployment_switchover(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueGreenDeploymentConfig_switchover(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "switchover", "false"),
+				),
+			},
+			{
+				Config: testAccBlueGreenDeploymentConfig_switchover(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "SWITCHOVER_COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "switchover", "true"),
+					resource.TestCheckResourceAttr(resourceName, "switchover_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "switchover_details.0.status", "SWITCHOVER_COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlueGreenDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_blue_green_deployment" && rs.Type != "aws_rds_cluster_blue_green_deployment" {
+				continue
+			}
+
+			_, err := tfrds.FindBlueGreenDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RDS Blue/Green Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlueGreenDeploymentExists(ctx context.Context, n string, v *types.BlueGreenDeployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindBlueGreenDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBlueGreenDeploymentConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  parameter_group_name    = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+}
+`, rName))
+}
+
+func testAccBlueGreenDeploymentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBlueGreenDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_blue_green_deployment" "test" {
+  blue_green_deployment_name = %[1]q
+  source                     = aws_db_instance.test.arn
+  delete_target              = true
+}
+`, rName))
+}
+
+func testAccBlueGreenDeploymentConfig_switchover(rName string, switchover bool) string {
+	return acctest.ConfigCompose(testAccBlueGreenDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_blue_green_deployment" "test" {
+  blue_green_deployment_name = %[1]q
+  source                     = aws_db_instance.test.arn
+  delete_target              = true
+  switchover                 = %[2]t
+
+  lifecycle {
+    ignore_changes = [source]
+  }
+}
+`, rName, switchover))
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_rds_cluster_blue_green_deployment", name="Cluster Blue/Green Deployment")
+// @Tags(identifierAttribute="arn")
+func ResourceClusterBlueGreenDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterBlueGreenDeploymentCreate,
+		ReadWithoutTimeout:   resourceClusterBlueGreenDeploymentRead,
+		UpdateWithoutTimeout: resourceClusterBlueGreenDeploymentUpdate,
+		DeleteWithoutTimeout: resourceClusterBlueGreenDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: blueGreenDeploymentSchema(map[string]*schema.Schema{
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_db_cluster_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"target_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"target_reader_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+
+		CustomizeDiff: customdiff.Sequence(
+			blueGreenDeploymentSwitchoverCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceClusterBlueGreenDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Get("blue_green_deployment_name").(string)),
+		Source:                  aws.String(d.Get("source").(string)),
+		Tags:                    blueGreenDeploymentTags(getTagsIn(ctx)),
+	}
+
+	if v, ok := d.GetOk("target_db_cluster_parameter_group_name"); ok {
+		input.TargetDBClusterParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_engine_version"); ok {
+		input.TargetEngineVersion = aws.String(v.(string))
+	}
+
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutCreate))
+
+	dep, err := blueGreenDeploymentCreate(ctx, d, conn, input, deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Cluster Blue/Green Deployment (%s): %s", d.Get("blue_green_deployment_name").(string), err)
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, meta.(*conns.AWSClient).RDSConn(ctx), aws.StringValue(dep.Target), deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Cluster Blue/Green Deployment (%s): waiting for Green environment: %s", d.Id(), err)
+	}
+
+	if d.Get("switchover").(bool) {
+		if _, err := blueGreenDeploymentSwitchover(ctx, d, conn, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RDS Cluster Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceClusterBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceClusterBlueGreenDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	dep, err := findBlueGreenDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Cluster Blue/Green Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	if err := blueGreenDeploymentSetResourceData(ctx, d, meta, dep); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	target, err := FindDBClusterByID(ctx, meta.(*conns.AWSClient).RDSConn(ctx), aws.StringValue(dep.Target))
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("target_endpoint", nil)
+		d.Set("target_port", nil)
+		d.Set("target_reader_endpoint", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Blue/Green Deployment (%s) target: %s", d.Id(), err)
+	default:
+		d.Set("target_db_cluster_parameter_group_name", target.DBClusterParameterGroup)
+		d.Set("target_endpoint", target.Endpoint)
+		d.Set("target_port", target.Port)
+		d.Set("target_reader_endpoint", target.ReaderEndpoint)
+	}
+
+	return diags
+}
+
+func resourceClusterBlueGreenDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+
+	if d.HasChange("target_db_cluster_parameter_group_name") {
+		conn := meta.(*conns.AWSClient).RDSConn(ctx)
+		targetARN := d.Get("target").(string)
+
+		target, err := FindDBClusterByID(ctx, conn, targetARN)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster Blue/Green Deployment (%s): reading Green environment: %s", d.Id(), err)
+		}
+
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:            aws.Bool(true),
+			DBClusterIdentifier:         target.DBClusterIdentifier,
+			DBClusterParameterGroupName: aws.String(d.Get("target_db_cluster_parameter_group_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating RDS Cluster Blue/Green Deployment (%s): Updating Green environment", d.Id())
+
+		if _, err := conn.ModifyDBClusterWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster Blue/Green Deployment (%s): updating Green environment: %s", d.Id(), err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, conn, targetARN, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster Blue/Green Deployment (%s): waiting for Green environment update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("switchover") && d.Get("switchover").(bool) {
+		if _, err := blueGreenDeploymentSwitchover(ctx, d, conn, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceClusterBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceClusterBlueGreenDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return blueGreenDeploymentDelete(ctx, d, meta)
+}
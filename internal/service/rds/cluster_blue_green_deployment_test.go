@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRDSClusterBlueGreenDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterBlueGreenDeploymentConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexache.MustCompile(`deployment:bgd-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "source", "aws_rds_cluster.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "switchover", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "target"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_reader_endpoint"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_target", "switchover_timeout"},
+			},
+		},
+	})
+}
+
+func TestAccRDSClusterBlueGreenDeployment_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.BlueGreenDeployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster_blue_green_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlueGreenDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterBlueGreenDeploymentConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccClusterBlueGreenDeploymentConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlueGreenDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterBlueGreenDeploymentConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "default" {
+  engine = "aurora-mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.default.engine
+  engine_version             = data.aws_rds_engine_version.default.version
+  preferred_instance_classes = ["db.t3.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = data.aws_rds_engine_version.default.parameter_group_family
+
+  parameter {
+    name         = "binlog_format"
+    value        = "ROW"
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  database_name                   = "test"
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  engine                          = data.aws_rds_engine_version.default.engine
+  engine_version                  = data.aws_rds_engine_version.default.version
+  master_username                 = "tfacctest"
+  master_password                 = "avoid-plaintext-passwords"
+  skip_final_snapshot             = true
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+  identifier         = %[1]q
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+`, rName)
+}
+
+func testAccClusterBlueGreenDeploymentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterBlueGreenDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_blue_green_deployment" "test" {
+  blue_green_deployment_name = %[1]q
+  source                     = aws_rds_cluster.test.arn
+  delete_target              = true
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, rName))
+}
+
+func testAccClusterBlueGreenDeploymentConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccClusterBlueGreenDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_blue_green_deployment" "test" {
+  blue_green_deployment_name = %[1]q
+  source                     = aws_rds_cluster.test.arn
+  delete_target              = true
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccClusterBlueGreenDeploymentConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccClusterBlueGreenDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_blue_green_deployment" "test" {
+  blue_green_deployment_name = %[1]q
+  source                     = aws_rds_cluster.test.arn
+  delete_target              = true
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -190,6 +190,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceBlueGreenDeployment,
+			TypeName: "aws_rds_blue_green_deployment",
+			Name:     "Blue/Green Deployment",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceCluster,
 			TypeName: "aws_rds_cluster",
@@ -202,6 +210,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceClusterActivityStream,
 			TypeName: "aws_rds_cluster_activity_stream",
 		},
+		{
+			Factory:  ResourceClusterBlueGreenDeployment,
+			TypeName: "aws_rds_cluster_blue_green_deployment",
+			Name:     "Cluster Blue/Green Deployment",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceClusterEndpoint,
 			TypeName: "aws_rds_cluster_endpoint",
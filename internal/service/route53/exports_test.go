@@ -5,9 +5,12 @@ package route53
 
 // Exports for use in tests only.
 var (
-	CIDRLocationParseResourceID  = cidrLocationParseResourceID
-	FindCIDRCollectionByID       = findCIDRCollectionByID
-	FindCIDRLocationByTwoPartKey = findCIDRLocationByTwoPartKey
-	ResourceCIDRCollection       = newResourceCIDRCollection
-	ResourceCIDRLocation         = newResourceCIDRLocation
+	CIDRLocationParseResourceID    = cidrLocationParseResourceID
+	ChunkRecordsChanges            = chunkRecordsChanges
+	FindCIDRCollectionByID         = findCIDRCollectionByID
+	FindCIDRLocationByTwoPartKey   = findCIDRLocationByTwoPartKey
+	FindResourceRecordSetsByZoneID = findResourceRecordSetsByZoneID
+	RecordsChanges                 = recordsChanges
+	ResourceCIDRCollection         = newResourceCIDRCollection
+	ResourceCIDRLocation           = newResourceCIDRLocation
 )
@@ -0,0 +1,692 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+const (
+	changeBatchMaxResourceRecords = 1000
+	changeBatchMaxValueCharacters = 32000
+)
+
+// @SDKResource("aws_route53_records", name="Records")
+func ResourceRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecordsCreate,
+		ReadWithoutTimeout:   resourceRecordsRead,
+		UpdateWithoutTimeout: resourceRecordsUpdate,
+		DeleteWithoutTimeout: resourceRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRecordsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										StateFunc:    NormalizeAliasName,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"zone_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 32),
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"location_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"failover_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(route53.ResourceRecordSetFailover_Values(), false),
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"latency_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"multivalue_answer_routing_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							StateFunc: func(v interface{}) string {
+								return strings.ToLower(strings.TrimSuffix(v.(string), "."))
+							},
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+						"weighted_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weight": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	rrsets, err := expandRecordsResourceRecordSets(d.Get("record").(*schema.Set).List(), zoneName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Records (%s): %s", zoneID, err)
+	}
+
+	// Protect existing DNS records which might be managed in another way.
+	action := route53.ChangeActionCreate
+	if d.Get("allow_overwrite").(bool) {
+		action = route53.ChangeActionUpsert
+	}
+
+	var changes []*route53.Change
+	for _, rrset := range rrsets {
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(action),
+			ResourceRecordSet: rrset,
+		})
+	}
+
+	if err := changeRecordsResourceRecordSets(ctx, conn, zoneID, "Managed by Terraform", changes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Records (%s): %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Records (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	remote, err := findResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	// Only the records that are managed by this resource are refreshed.
+	// Configured names are preserved so that relative and fully qualified names don't cause a diff.
+	var records []interface{}
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		name := tfMap["name"].(string)
+		key := recordsResourceRecordSetKey(ExpandRecordName(name, zoneName), tfMap["type"].(string), tfMap["set_identifier"].(string))
+
+		rrset, ok := remote[key]
+		if !ok {
+			continue
+		}
+
+		tfMap = flattenRecordsResourceRecordSet(rrset)
+		tfMap["name"] = name
+		records = append(records, tfMap)
+	}
+
+	if !d.IsNewResource() && len(records) == 0 {
+		log.Printf("[WARN] Route 53 Records (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("record", records); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+	d.Set("zone_id", d.Id())
+
+	return diags
+}
+
+func resourceRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	if d.HasChange("record") {
+		zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+		}
+
+		zoneName := aws.StringValue(zone.HostedZone.Name)
+		o, n := d.GetChange("record")
+
+		oldRRSets, err := expandRecordsResourceRecordSets(o.(*schema.Set).List(), zoneName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route 53 Records (%s): %s", d.Id(), err)
+		}
+
+		newRRSets, err := expandRecordsResourceRecordSets(n.(*schema.Set).List(), zoneName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route 53 Records (%s): %s", d.Id(), err)
+		}
+
+		if err := changeRecordsResourceRecordSets(ctx, conn, d.Id(), "Managed by Terraform", recordsChanges(oldRRSets, newRRSets)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route 53 Records (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	remote, err := findResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	// Deletes must exactly match the current record sets.
+	var changes []*route53.Change
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		key := recordsResourceRecordSetKey(ExpandRecordName(tfMap["name"].(string), zoneName), tfMap["type"].(string), tfMap["set_identifier"].(string))
+
+		if rrset, ok := remote[key]; ok {
+			changes = append(changes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: rrset,
+			})
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 Records: %s", d.Id())
+	if err := changeRecordsResourceRecordSets(ctx, conn, d.Id(), "Deleted by Terraform", changes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceRecordsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Id())
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	zoneName := FQDN(strings.ToLower(aws.StringValue(zone.HostedZone.Name)))
+	remote, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Records (%s): %w", zoneID, err)
+	}
+
+	// Import every record in the zone except the apex SOA and NS records, which are managed with the zone itself.
+	var records []interface{}
+	for _, rrset := range remote {
+		name := strings.ToLower(CleanRecordName(aws.StringValue(rrset.Name)))
+		typ := aws.StringValue(rrset.Type)
+
+		if name == zoneName && (typ == route53.RRTypeSoa || typ == route53.RRTypeNs) {
+			continue
+		}
+
+		tfMap := flattenRecordsResourceRecordSet(rrset)
+		tfMap["name"] = strings.TrimSuffix(name, ".")
+		records = append(records, tfMap)
+	}
+
+	d.SetId(zoneID)
+	d.Set("allow_overwrite", false)
+	if err := d.Set("record", records); err != nil {
+		return nil, fmt.Errorf("setting record: %w", err)
+	}
+	d.Set("zone_id", zoneID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findResourceRecordSetsByZoneID returns all the record sets in the specified hosted zone keyed by recordsResourceRecordSetKey.
+func findResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Route53, zoneID string) (map[string]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	output := make(map[string]*route53.ResourceRecordSet)
+
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v == nil {
+				continue
+			}
+
+			output[recordsResourceRecordSetKey(CleanRecordName(aws.StringValue(v.Name)), aws.StringValue(v.Type), aws.StringValue(v.SetIdentifier))] = v
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// changeRecordsResourceRecordSets submits the changes in as few change batches as the API limits allow
+// and then waits for all of them to propagate.
+func changeRecordsResourceRecordSets(ctx context.Context, conn *route53.Route53, zoneID, comment string, changes []*route53.Change) error {
+	var changeIDs []string
+
+	for _, chunk := range chunkRecordsChanges(changes) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: aws.String(comment),
+				Changes: chunk,
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return err
+		}
+
+		if changeInfo != nil {
+			changeIDs = append(changeIDs, CleanChangeID(aws.StringValue(changeInfo.Id)))
+		}
+	}
+
+	for _, changeID := range changeIDs {
+		if _, err := waitChangeInfoStatusInsync(ctx, conn, changeID); err != nil {
+			return fmt.Errorf("waiting for Route 53 Change (%s) sync: %w", changeID, err)
+		}
+	}
+
+	return nil
+}
+
+// chunkRecordsChanges splits changes into batches that respect the ChangeResourceRecordSets limits.
+// UPSERT changes count twice towards the limits.
+func chunkRecordsChanges(changes []*route53.Change) [][]*route53.Change {
+	var chunks [][]*route53.Change
+	var chunk []*route53.Change
+	var records, characters int
+
+	for _, change := range changes {
+		n, c := 1, 0
+		if rrset := change.ResourceRecordSet; rrset != nil && len(rrset.ResourceRecords) > 0 {
+			n = len(rrset.ResourceRecords)
+			for _, v := range rrset.ResourceRecords {
+				c += len(aws.StringValue(v.Value))
+			}
+		}
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			n, c = n*2, c*2
+		}
+
+		if len(chunk) > 0 && (records+n > changeBatchMaxResourceRecords || characters+c > changeBatchMaxValueCharacters) {
+			chunks = append(chunks, chunk)
+			chunk, records, characters = nil, 0, 0
+		}
+
+		chunk = append(chunk, change)
+		records += n
+		characters += c
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// recordsChanges computes the minimal set of changes to go from the old to the new record sets.
+// Deletions are ordered first so that a record set can be replaced by one of a conflicting type.
+func recordsChanges(o, n map[string]*route53.ResourceRecordSet) []*route53.Change {
+	var deletes, upserts []*route53.Change
+
+	for k, v := range o {
+		if _, ok := n[k]; !ok {
+			deletes = append(deletes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: v,
+			})
+		}
+	}
+
+	for k, v := range n {
+		if old, ok := o[k]; ok && reflect.DeepEqual(old, v) {
+			continue
+		}
+
+		upserts = append(upserts, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: v,
+		})
+	}
+
+	return append(deletes, upserts...)
+}
+
+func recordsResourceRecordSetKey(name, recordType, setIdentifier string) string {
+	return strings.Join([]string{FQDN(strings.ToLower(name)), strings.ToUpper(recordType), setIdentifier}, "_")
+}
+
+func expandRecordsResourceRecordSets(tfList []interface{}, zoneName string) (map[string]*route53.ResourceRecordSet, error) {
+	apiObjects := make(map[string]*route53.ResourceRecordSet, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := expandRecordsResourceRecordSet(tfMap, zoneName)
+		key := recordsResourceRecordSetKey(aws.StringValue(apiObject.Name), aws.StringValue(apiObject.Type), aws.StringValue(apiObject.SetIdentifier))
+
+		if _, ok := apiObjects[key]; ok {
+			return nil, fmt.Errorf("duplicate record (%s %s %s)", aws.StringValue(apiObject.Name), aws.StringValue(apiObject.Type), aws.StringValue(apiObject.SetIdentifier))
+		}
+
+		apiObjects[key] = apiObject
+	}
+
+	return apiObjects, nil
+}
+
+func expandRecordsResourceRecordSet(tfMap map[string]interface{}, zoneName string) *route53.ResourceRecordSet {
+	recordType := tfMap["type"].(string)
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String(ExpandRecordName(tfMap["name"].(string), zoneName)),
+		Type: aws.String(recordType),
+	}
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := v[0].(map[string]interface{})
+		apiObject.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(alias["name"].(string)),
+			EvaluateTargetHealth: aws.Bool(alias["evaluate_target_health"].(bool)),
+			HostedZoneId:         aws.String(alias["zone_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["cidr_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cidr := v[0].(map[string]interface{})
+		apiObject.CidrRoutingConfig = &route53.CidrRoutingConfig{
+			CollectionId: aws.String(cidr["collection_id"].(string)),
+			LocationName: aws.String(cidr["location_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Failover = aws.String(v[0].(map[string]interface{})["type"].(string))
+	}
+
+	if v, ok := tfMap["geolocation_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		geolocation := v[0].(map[string]interface{})
+		apiObject.GeoLocation = &route53.GeoLocation{
+			ContinentCode:   nilString(geolocation["continent"].(string)),
+			CountryCode:     nilString(geolocation["country"].(string)),
+			SubdivisionCode: nilString(geolocation["subdivision"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["health_check_id"].(string); ok && v != "" {
+		apiObject.HealthCheckId = aws.String(v)
+	}
+
+	if v, ok := tfMap["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Region = aws.String(v[0].(map[string]interface{})["region"].(string))
+	}
+
+	if v, ok := tfMap["multivalue_answer_routing_policy"].(bool); ok && v {
+		apiObject.MultiValueAnswer = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["records"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRecords = expandResourceRecords(v.List(), recordType)
+	}
+
+	if v, ok := tfMap["set_identifier"].(string); ok && v != "" {
+		apiObject.SetIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["ttl"].(int); ok && v != 0 {
+		apiObject.TTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Weight = aws.Int64(int64(v[0].(map[string]interface{})["weight"].(int)))
+	}
+
+	return apiObject
+}
+
+func flattenRecordsResourceRecordSet(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	recordType := aws.StringValue(apiObject.Type)
+	tfMap := map[string]interface{}{
+		"health_check_id":                  aws.StringValue(apiObject.HealthCheckId),
+		"multivalue_answer_routing_policy": aws.BoolValue(apiObject.MultiValueAnswer),
+		"name":                             strings.TrimSuffix(strings.ToLower(CleanRecordName(aws.StringValue(apiObject.Name))), "."),
+		"records":                          FlattenResourceRecords(apiObject.ResourceRecords, recordType),
+		"set_identifier":                   aws.StringValue(apiObject.SetIdentifier),
+		"ttl":                              int(aws.Int64Value(apiObject.TTL)),
+		"type":                             recordType,
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	if v := apiObject.CidrRoutingConfig; v != nil {
+		tfMap["cidr_routing_policy"] = []interface{}{map[string]interface{}{
+			"collection_id": aws.StringValue(v.CollectionId),
+			"location_name": aws.StringValue(v.LocationName),
+		}}
+	}
+
+	if v := apiObject.Failover; v != nil {
+		tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.GeoLocation; v != nil {
+		tfMap["geolocation_routing_policy"] = []interface{}{map[string]interface{}{
+			"continent":   aws.StringValue(v.ContinentCode),
+			"country":     aws.StringValue(v.CountryCode),
+			"subdivision": aws.StringValue(v.SubdivisionCode),
+		}}
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+			"region": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+			"weight": int(aws.Int64Value(v)),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,453 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestChunkRecordsChanges(t *testing.T) {
+	t.Parallel()
+
+	change := func(action string, values ...string) *route53.Change {
+		rrset := &route53.ResourceRecordSet{
+			Name: aws.String("example.com"),
+			Type: aws.String(route53.RRTypeTxt),
+		}
+		for _, v := range values {
+			rrset.ResourceRecords = append(rrset.ResourceRecords, &route53.ResourceRecord{Value: aws.String(v)})
+		}
+		return &route53.Change{Action: aws.String(action), ResourceRecordSet: rrset}
+	}
+	changes := func(n int, action string, values ...string) []*route53.Change {
+		var output []*route53.Change
+		for i := 0; i < n; i++ {
+			output = append(output, change(action, values...))
+		}
+		return output
+	}
+
+	testCases := []struct {
+		name     string
+		changes  []*route53.Change
+		expected []int
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "single batch",
+			changes:  changes(1000, route53.ChangeActionCreate, "a"),
+			expected: []int{1000},
+		},
+		{
+			name:     "resource record limit",
+			changes:  changes(1001, route53.ChangeActionCreate, "a"),
+			expected: []int{1000, 1},
+		},
+		{
+			name:     "upserts count twice",
+			changes:  changes(501, route53.ChangeActionUpsert, "a"),
+			expected: []int{500, 1},
+		},
+		{
+			name:     "value character limit",
+			changes:  changes(5, route53.ChangeActionDelete, strings.Repeat("a", 10000)),
+			expected: []int{3, 2},
+		},
+		{
+			name:     "oversized change",
+			changes:  changes(2, route53.ChangeActionCreate, strings.Repeat("a", 40000)),
+			expected: []int{1, 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []int
+			for _, chunk := range tfroute53.ChunkRecordsChanges(testCase.changes) {
+				got = append(got, len(chunk))
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(testCase.expected) {
+				t.Errorf("expected chunk sizes %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestRecordsChanges(t *testing.T) {
+	t.Parallel()
+
+	rrset := func(name string, ttl int64) *route53.ResourceRecordSet {
+		return &route53.ResourceRecordSet{
+			Name:            aws.String(name),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("127.0.0.1")}},
+			TTL:             aws.Int64(ttl),
+			Type:            aws.String(route53.RRTypeA),
+		}
+	}
+
+	o := map[string]*route53.ResourceRecordSet{
+		"unchanged": rrset("unchanged.example.com", 30),
+		"changed":   rrset("changed.example.com", 30),
+		"removed":   rrset("removed.example.com", 30),
+	}
+	n := map[string]*route53.ResourceRecordSet{
+		"unchanged": rrset("unchanged.example.com", 30),
+		"changed":   rrset("changed.example.com", 60),
+		"added":     rrset("added.example.com", 30),
+	}
+
+	changes := tfroute53.RecordsChanges(o, n)
+
+	if got, expected := len(changes), 3; got != expected {
+		t.Fatalf("expected %d changes, got %d", expected, got)
+	}
+
+	if got, expected := aws.StringValue(changes[0].Action), route53.ChangeActionDelete; got != expected {
+		t.Errorf("expected first change to be %s, got %s", expected, got)
+	}
+
+	if got, expected := aws.StringValue(changes[0].ResourceRecordSet.Name), "removed.example.com"; got != expected {
+		t.Errorf("expected deleted record %s, got %s", expected, got)
+	}
+
+	for _, change := range changes[1:] {
+		if got, expected := aws.StringValue(change.Action), route53.ChangeActionUpsert; got != expected {
+			t.Errorf("expected change to be %s, got %s", expected, got)
+		}
+
+		if name := aws.StringValue(change.ResourceRecordSet.Name); name == "unchanged.example.com" {
+			t.Errorf("unexpected change for %s", name)
+		}
+	}
+}
+
+func TestAccRoute53Records_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_overwrite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www." + zoneName.String(),
+						"type":      "A",
+						"ttl":       "30",
+						"records.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "mail." + zoneName.String(),
+						"type":      "MX",
+						"ttl":       "300",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      zoneName.String(),
+						"type":      "TXT",
+						"ttl":       "300",
+						"records.#": "1",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite"},
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_updated(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www." + zoneName.String(),
+						"type":      "A",
+						"ttl":       "60",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "ftp." + zoneName.String(),
+						"type":      "CNAME",
+						"ttl":       "300",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": zoneName.String(),
+						"type": "TXT",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_many(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_many(zoneName.String(), 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceRecords(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccRecordsCount returns the number of record sets in the zone, excluding the apex SOA and NS records.
+func testAccRecordsCount(ctx context.Context, conn *route53.Route53, zoneID string) (int, error) {
+	zone, err := tfroute53.FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return 0, err
+	}
+
+	output, err := tfroute53.FindResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, v := range output {
+		if strings.EqualFold(aws.StringValue(v.Name), aws.StringValue(zone.HostedZone.Name)) {
+			if typ := aws.StringValue(v.Type); typ == route53.RRTypeSoa || typ == route53.RRTypeNs {
+				continue
+			}
+		}
+		n++
+	}
+
+	return n, nil
+}
+
+func testAccCheckRecordsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		got, err := testAccRecordsCount(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		expected, err := strconv.Atoi(rs.Primary.Attributes["record.#"])
+
+		if err != nil {
+			return err
+		}
+
+		if got != expected {
+			return fmt.Errorf("Route 53 Records (%s): expected %d records, got %d", rs.Primary.ID, expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_records" {
+				continue
+			}
+
+			n, err := testAccRecordsCount(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if n > 0 {
+				return fmt.Errorf("Route 53 Records %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRecordsConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 30
+    records = ["127.0.0.1", "127.0.0.27"]
+  }
+
+  record {
+    name    = "mail.%[1]s"
+    type    = "MX"
+    ttl     = 300
+    records = ["10 mail.example.com"]
+  }
+
+  record {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 60
+    records = ["127.0.0.1"]
+  }
+
+  record {
+    name    = "ftp.%[1]s"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["www.%[1]s"]
+  }
+
+  record {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_many(zoneName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  dynamic "record" {
+    for_each = range(%[2]d)
+
+    content {
+      name    = "record${record.value}.%[1]s"
+      type    = "A"
+      ttl     = 30
+      records = ["127.0.0.1", "127.0.0.2"]
+    }
+  }
+}
+`, zoneName, n)
+}
@@ -75,6 +75,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRecord,
 			TypeName: "aws_route53_record",
 		},
+		{
+			Factory:  ResourceRecords,
+			TypeName: "aws_route53_records",
+			Name:     "Records",
+		},
 		{
 			Factory:  ResourceTrafficPolicy,
 			TypeName: "aws_route53_traffic_policy",
@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="AWS Log Source")
+func newAWSLogSourceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &awsLogSourceResource{}
+
+	return r, nil
+}
+
+type awsLogSourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *awsLogSourceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_aws_log_source"
+}
+
+func (r *awsLogSourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[awsLogSourceSourceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"accounts": schema.SetAttribute{
+							CustomType:  fwtypes.NewSetTypeOf[types.String](ctx),
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"regions": schema.SetAttribute{
+							CustomType:  fwtypes.NewSetTypeOf[types.String](ctx),
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"source_name": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AwsLogSourceName](),
+							Required:   true,
+						},
+						"source_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+			},
+		},
+	}
+}
+
+func (r *awsLogSourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data awsLogSourceResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	source, diags := data.Source.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var sourceConfiguration awstypes.AwsLogSourceConfiguration
+	response.Diagnostics.Append(fwflex.Expand(ctx, source, &sourceConfiguration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateAwsLogSourceInput{
+		Sources: []awstypes.AwsLogSourceConfiguration{sourceConfiguration},
+	}
+
+	// Log source changes conflict with concurrent data lake updates.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateAwsLogSource(ctx, input)
+	})
+
+	if err == nil {
+		if v := outputRaw.(*securitylake.CreateAwsLogSourceOutput).Failed; len(v) > 0 {
+			err = fmt.Errorf("failed in Regions: %s", strings.Join(v, ", "))
+		}
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake AWS Log Source (%s)", source.SourceName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = source.SourceName.StringValue
+
+	logSource, err := findAWSLogSourceBySourceName(ctx, conn, source.SourceName.ValueEnum())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	source.Accounts = fwtypes.SetValueOf[types.String]{SetValue: fwflex.FlattenFrameworkStringValueSetLegacy(ctx, logSource.Accounts)}
+	source.SourceVersion = fwflex.StringToFramework(ctx, logSource.SourceVersion)
+	data.Source = fwtypes.NewListNestedObjectValueOfPtr(ctx, source)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *awsLogSourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data awsLogSourceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	logSource, err := findAWSLogSourceBySourceName(ctx, conn, awstypes.AwsLogSourceName(data.ID.ValueString()))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var source awsLogSourceSourceModel
+	response.Diagnostics.Append(fwflex.Flatten(ctx, logSource, &source)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Source = fwtypes.NewListNestedObjectValueOfPtr(ctx, &source)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *awsLogSourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *awsLogSourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data awsLogSourceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	source, diags := data.Source.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var sourceConfiguration awstypes.AwsLogSourceConfiguration
+	response.Diagnostics.Append(fwflex.Expand(ctx, source, &sourceConfiguration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.DeleteAwsLogSourceInput{
+		Sources: []awstypes.AwsLogSourceConfiguration{sourceConfiguration},
+	}
+
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.DeleteAwsLogSource(ctx, input)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err == nil {
+		if v := outputRaw.(*securitylake.DeleteAwsLogSourceOutput).Failed; len(v) > 0 {
+			err = fmt.Errorf("failed in Regions: %s", strings.Join(v, ", "))
+		}
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// findAWSLogSourceBySourceName aggregates the accounts and Regions in which the specified AWS log source is enabled.
+func findAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName awstypes.AwsLogSourceName) (*awstypes.AwsLogSourceConfiguration, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName: sourceName,
+				},
+			},
+		},
+	}
+
+	var accounts, regions []string
+	var sourceVersion *string
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				v, ok := v.(*awstypes.LogSourceResourceMemberAwsLogSource)
+
+				if !ok || v.Value.SourceName != sourceName {
+					continue
+				}
+
+				accounts = appendUnique(accounts, aws.ToString(logSource.Account))
+				regions = appendUnique(regions, aws.ToString(logSource.Region))
+				sourceVersion = v.Value.SourceVersion
+			}
+		}
+	}
+
+	if len(regions) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return &awstypes.AwsLogSourceConfiguration{
+		Accounts:      accounts,
+		Regions:       regions,
+		SourceName:    sourceName,
+		SourceVersion: sourceVersion,
+	}, nil
+}
+
+func appendUnique(s []string, v string) []string {
+	for _, e := range s {
+		if e == v {
+			return s
+		}
+	}
+
+	return append(s, v)
+}
+
+type awsLogSourceResourceModel struct {
+	ID     types.String                                             `tfsdk:"id"`
+	Source fwtypes.ListNestedObjectValueOf[awsLogSourceSourceModel] `tfsdk:"source"`
+}
+
+type awsLogSourceSourceModel struct {
+	Accounts      fwtypes.SetValueOf[types.String]              `tfsdk:"accounts"`
+	Regions       fwtypes.SetValueOf[types.String]              `tfsdk:"regions"`
+	SourceName    fwtypes.StringEnum[awstypes.AwsLogSourceName] `tfsdk:"source_name"`
+	SourceVersion types.String                                  `tfsdk:"source_version"`
+}
@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Log Source")
+func newCustomLogSourceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &customLogSourceResource{}
+
+	return r, nil
+}
+
+type customLogSourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *customLogSourceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_custom_log_source"
+}
+
+func (r *customLogSourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"attributes": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[customLogSourceAttributesModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[customLogSourceAttributesModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_classes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"provider_details": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[customLogSourceProviderModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[customLogSourceProviderModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"crawler_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceCrawlerConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.IsRequired(),
+							},
+						},
+						"provider_identity": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceProviderIdentityModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"external_id": schema.StringAttribute{
+										Required: true,
+									},
+									"principal": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.IsRequired(),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+			},
+		},
+	}
+}
+
+func (r *customLogSourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customLogSourceResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateCustomLogSourceInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Log source changes conflict with concurrent data lake updates.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateCustomLogSource(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Custom Log Source (%s)", data.SourceName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	output := outputRaw.(*securitylake.CreateCustomLogSourceOutput).Source
+	response.Diagnostics.Append(data.flattenCustomLogSourceResource(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customLogSourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customLogSourceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findCustomLogSourceBySourceName(ctx, conn, data.SourceName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The source's configuration and event classes are not returned by the API.
+	response.Diagnostics.Append(data.flattenCustomLogSourceResource(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customLogSourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *customLogSourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customLogSourceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.DeleteCustomLogSourceInput{
+		SourceName:    fwflex.StringFromFramework(ctx, data.SourceName),
+		SourceVersion: fwflex.StringFromFramework(ctx, data.SourceVersion),
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.DeleteCustomLogSource(ctx, input)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findCustomLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*awstypes.CustomLogSourceResource, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName: aws.String(sourceName),
+				},
+			},
+		},
+	}
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				if v, ok := v.(*awstypes.LogSourceResourceMemberCustomLogSource); ok && aws.ToString(v.Value.SourceName) == sourceName {
+					return &v.Value, nil
+				}
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+type customLogSourceResourceModel struct {
+	Attributes      fwtypes.ListNestedObjectValueOf[customLogSourceAttributesModel]    `tfsdk:"attributes"`
+	Configuration   fwtypes.ListNestedObjectValueOf[customLogSourceConfigurationModel] `tfsdk:"configuration"`
+	EventClasses    types.Set                                                          `tfsdk:"event_classes"`
+	ID              types.String                                                       `tfsdk:"id"`
+	ProviderDetails fwtypes.ListNestedObjectValueOf[customLogSourceProviderModel]      `tfsdk:"provider_details"`
+	SourceName      types.String                                                       `tfsdk:"source_name"`
+	SourceVersion   types.String                                                       `tfsdk:"source_version"`
+}
+
+type customLogSourceConfigurationModel struct {
+	CrawlerConfiguration fwtypes.ListNestedObjectValueOf[customLogSourceCrawlerConfigurationModel] `tfsdk:"crawler_configuration"`
+	ProviderIdentity     fwtypes.ListNestedObjectValueOf[customLogSourceProviderIdentityModel]     `tfsdk:"provider_identity"`
+}
+
+type customLogSourceCrawlerConfigurationModel struct {
+	RoleARN fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+type customLogSourceProviderIdentityModel struct {
+	ExternalID types.String `tfsdk:"external_id"`
+	Principal  types.String `tfsdk:"principal"`
+}
+
+type customLogSourceAttributesModel struct {
+	CrawlerARN  types.String `tfsdk:"crawler_arn"`
+	DatabaseARN types.String `tfsdk:"database_arn"`
+	TableARN    types.String `tfsdk:"table_arn"`
+}
+
+type customLogSourceProviderModel struct {
+	Location types.String `tfsdk:"location"`
+	RoleARN  types.String `tfsdk:"role_arn"`
+}
+
+func (data *customLogSourceResourceModel) flattenCustomLogSourceResource(ctx context.Context, apiObject *awstypes.CustomLogSourceResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Attributes = fwtypes.NewListNestedObjectValueOfNull[customLogSourceAttributesModel](ctx)
+	if v := apiObject.Attributes; v != nil {
+		var attributes customLogSourceAttributesModel
+		diags.Append(fwflex.Flatten(ctx, v, &attributes)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		data.Attributes = fwtypes.NewListNestedObjectValueOfPtr(ctx, &attributes)
+	}
+
+	data.ProviderDetails = fwtypes.NewListNestedObjectValueOfNull[customLogSourceProviderModel](ctx)
+	if v := apiObject.Provider; v != nil {
+		var provider customLogSourceProviderModel
+		diags.Append(fwflex.Flatten(ctx, v, &provider)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		data.ProviderDetails = fwtypes.NewListNestedObjectValueOfPtr(ctx, &provider)
+	}
+
+	data.SourceName = fwflex.StringToFramework(ctx, apiObject.SourceName)
+	data.SourceVersion = fwflex.StringToFramework(ctx, apiObject.SourceVersion)
+
+	return diags
+}
+
+func (data *customLogSourceResourceModel) InitFromID() {
+	data.SourceName = data.ID
+}
+
+func (data *customLogSourceResourceModel) setID() {
+	data.ID = data.SourceName
+}
@@ -0,0 +1,559 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// s3ManagedKeyID is the KMS key ID reported for data lakes encrypted with S3-managed keys.
+	s3ManagedKeyID = "S3_MANAGED_KEY"
+)
+
+// @FrameworkResource(name="Data Lake")
+// @Tags(identifierAttribute="arn")
+func newDataLakeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataLakeResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type dataLakeResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *dataLakeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_data_lake"
+}
+
+func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"meta_store_manager_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeEncryptionConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"kms_key_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"lifecycle_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeLifecycleConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"expiration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeLifecycleExpirationModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Required: true,
+												},
+											},
+										},
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+									},
+									"transition": schema.SetNestedBlock{
+										CustomType: fwtypes.NewSetNestedObjectTypeOf[dataLakeLifecycleTransitionModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Required: true,
+												},
+												"storage_class": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"replication_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeReplicationConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"regions": schema.SetAttribute{
+										CustomType:  fwtypes.NewSetTypeOf[types.String](ctx),
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *dataLakeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataLakeResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateDataLakeInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDataLake(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Security Lake Data Lake", err.Error())
+
+		return
+	}
+
+	if len(output.DataLakes) == 0 {
+		response.Diagnostics.AddError("creating Security Lake Data Lake", "empty result")
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.DataLakes[0].DataLakeArn)
+	data.setID()
+
+	dataLake, err := waitDataLakeCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.S3BucketARN = fwflex.StringToFramework(ctx, dataLake.S3BucketArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dataLakeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataLakeResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	dataLake, err := findDataLakeByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if v := dataLake.EncryptionConfiguration; v != nil && aws.ToString(v.KmsKeyId) == s3ManagedKeyID {
+		dataLake.EncryptionConfiguration = nil
+	}
+
+	var configuration dataLakeConfigurationModel
+	response.Diagnostics.Append(fwflex.Flatten(ctx, dataLake, &configuration)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Configuration = fwtypes.NewListNestedObjectValueOfPtr(ctx, &configuration)
+	data.S3BucketARN = fwflex.StringToFramework(ctx, dataLake.S3BucketArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dataLakeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new dataLakeResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) {
+		input := &securitylake.UpdateDataLakeInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDataLake(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Data Lake (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *dataLakeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dataLakeResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	region, err := regionFromARN(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	_, err = conn.DeleteDataLake(ctx, &securitylake.DeleteDataLakeInput{
+		Regions: []string{region},
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDataLakeDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *dataLakeResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func regionFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	return v.Region, nil
+}
+
+func findDataLakeByARN(ctx context.Context, conn *securitylake.Client, dataLakeARN string) (*awstypes.DataLakeResource, error) {
+	region, err := regionFromARN(dataLakeARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	}
+
+	output, err := conn.ListDataLakes(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.DataLakes {
+		if aws.ToString(v.DataLakeArn) == dataLakeARN {
+			v := v
+
+			// A data lake whose creation never started is reported by the API after deletion.
+			if v.CreateStatus == awstypes.DataLakeStatusInitialized && v.S3BucketArn == nil {
+				break
+			}
+
+			return &v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func statusDataLakeCreate(ctx context.Context, conn *securitylake.Client, dataLakeARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, dataLakeARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.CreateStatus), nil
+	}
+}
+
+func statusDataLakeUpdate(ctx context.Context, conn *securitylake.Client, dataLakeARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, dataLakeARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.UpdateStatus == nil {
+			return output, string(awstypes.DataLakeStatusCompleted), nil
+		}
+
+		return output, string(output.UpdateStatus.Status), nil
+	}
+}
+
+func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, dataLakeARN string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending),
+		Target:         enum.Slice(awstypes.DataLakeStatusCompleted),
+		Refresh:        statusDataLakeCreate(ctx, conn, dataLakeARN),
+		Timeout:        timeout,
+		Delay:          30 * time.Second,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, dataLakeARN string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending),
+		Target:  enum.Slice(awstypes.DataLakeStatusCompleted),
+		Refresh: statusDataLakeUpdate(ctx, conn, dataLakeARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		if v := output.UpdateStatus; v != nil && v.Exception != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.Exception.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, dataLakeARN string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending, awstypes.DataLakeStatusCompleted),
+		Target:  []string{},
+		Refresh: statusDataLakeCreate(ctx, conn, dataLakeARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type dataLakeResourceModel struct {
+	ARN                     types.String                                                `tfsdk:"arn"`
+	Configuration           fwtypes.ListNestedObjectValueOf[dataLakeConfigurationModel] `tfsdk:"configuration"`
+	ID                      types.String                                                `tfsdk:"id"`
+	MetaStoreManagerRoleARN fwtypes.ARN                                                 `tfsdk:"meta_store_manager_role_arn"`
+	S3BucketARN             types.String                                                `tfsdk:"s3_bucket_arn"`
+	Tags                    types.Map                                                   `tfsdk:"tags"`
+	TagsAll                 types.Map                                                   `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+type dataLakeConfigurationModel struct {
+	EncryptionConfiguration  fwtypes.ListNestedObjectValueOf[dataLakeEncryptionConfigurationModel]  `tfsdk:"encryption_configuration"`
+	LifecycleConfiguration   fwtypes.ListNestedObjectValueOf[dataLakeLifecycleConfigurationModel]   `tfsdk:"lifecycle_configuration"`
+	Region                   types.String                                                           `tfsdk:"region"`
+	ReplicationConfiguration fwtypes.ListNestedObjectValueOf[dataLakeReplicationConfigurationModel] `tfsdk:"replication_configuration"`
+}
+
+type dataLakeEncryptionConfigurationModel struct {
+	KMSKeyID types.String `tfsdk:"kms_key_id"`
+}
+
+type dataLakeLifecycleConfigurationModel struct {
+	Expiration fwtypes.ListNestedObjectValueOf[dataLakeLifecycleExpirationModel] `tfsdk:"expiration"`
+	Transition fwtypes.SetNestedObjectValueOf[dataLakeLifecycleTransitionModel]  `tfsdk:"transition"`
+}
+
+type dataLakeLifecycleExpirationModel struct {
+	Days types.Int64 `tfsdk:"days"`
+}
+
+type dataLakeLifecycleTransitionModel struct {
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+type dataLakeReplicationConfigurationModel struct {
+	Regions fwtypes.SetValueOf[types.String] `tfsdk:"regions"`
+	RoleARN fwtypes.ARN                      `tfsdk:"role_arn"`
+}
+
+func (data *dataLakeResourceModel) InitFromID() {
+	data.ARN = data.ID
+}
+
+func (data *dataLakeResourceModel) setID() {
+	data.ID = data.ARN
+}
@@ -0,0 +1,565 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber")
+// @Tags(identifierAttribute="arn")
+func newSubscriberResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &subscriberResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type subscriberResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *subscriberResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber"
+}
+
+func (r *subscriberResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	sourceResourceBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberSourceResourceModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"source_name": schema.StringAttribute{
+						Required: true,
+					},
+					"source_version": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"resource_share_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_share_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_description": schema.StringAttribute{
+				Optional: true,
+			},
+			"subscriber_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_name": schema.StringAttribute{
+				Required: true,
+			},
+			"subscriber_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SubscriberStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[subscriberSourceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_log_source_resource":    sourceResourceBlock(),
+						"custom_log_source_resource": sourceResourceBlock(),
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"subscriber_identity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberIdentityModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *subscriberResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data subscriberResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateSubscriberInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sources, diags := expandSubscriberSources(ctx, data.LogSources)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AccessType.IsNull() && !data.AccessType.IsUnknown() {
+		input.AccessTypes = []awstypes.AccessType{data.AccessType.ValueEnum()}
+	}
+	input.Sources = sources
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber (%s)", data.SubscriberName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.Subscriber.SubscriberId)
+
+	subscriber, err := waitSubscriberCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data subscriberResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new subscriberResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.LogSources.Equal(old.LogSources) ||
+		!new.SubscriberDescription.Equal(old.SubscriberDescription) ||
+		!new.SubscriberIdentity.Equal(old.SubscriberIdentity) ||
+		!new.SubscriberName.Equal(old.SubscriberName) {
+		input := &securitylake.UpdateSubscriberInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		sources, diags := expandSubscriberSources(ctx, new.LogSources)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Sources = sources
+		input.SubscriberId = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdateSubscriber(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, output.Subscriber)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.SubscriberStatus = old.SubscriberStatus
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *subscriberResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data subscriberResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitSubscriberDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *subscriberResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*awstypes.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
+
+func statusSubscriber(ctx context.Context, conn *securitylake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSubscriberByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SubscriberStatus), nil
+	}
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.SubscriberStatusPending),
+		Target:                    enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh:                   statusSubscriber(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusDeactivated, awstypes.SubscriberStatusPending, awstypes.SubscriberStatusReady),
+		Target:  []string{},
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandSubscriberSources(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[subscriberSourceModel]) ([]awstypes.LogSourceResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfSet.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.LogSourceResource, 0, len(data))
+
+	for _, v := range data {
+		if !v.AWSLogSourceResource.IsNull() {
+			source, d := v.AWSLogSourceResource.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName:    awstypes.AwsLogSourceName(source.SourceName.ValueString()),
+					SourceVersion: fwflex.StringFromFramework(ctx, source.SourceVersion),
+				},
+			})
+		}
+
+		if !v.CustomLogSourceResource.IsNull() {
+			source, d := v.CustomLogSourceResource.ToPtr(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName:    fwflex.StringFromFramework(ctx, source.SourceName),
+					SourceVersion: fwflex.StringFromFramework(ctx, source.SourceVersion),
+				},
+			})
+		}
+	}
+
+	return apiObjects, diags
+}
+
+func flattenSubscriberSources(ctx context.Context, apiObjects []awstypes.LogSourceResource) fwtypes.SetNestedObjectValueOf[subscriberSourceModel] {
+	data := make([]*subscriberSourceModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		source := &subscriberSourceModel{
+			AWSLogSourceResource:    fwtypes.NewListNestedObjectValueOfNull[subscriberSourceResourceModel](ctx),
+			CustomLogSourceResource: fwtypes.NewListNestedObjectValueOfNull[subscriberSourceResourceModel](ctx),
+		}
+
+		switch v := apiObject.(type) {
+		case *awstypes.LogSourceResourceMemberAwsLogSource:
+			source.AWSLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &subscriberSourceResourceModel{
+				SourceName:    types.StringValue(string(v.Value.SourceName)),
+				SourceVersion: fwflex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		case *awstypes.LogSourceResourceMemberCustomLogSource:
+			source.CustomLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &subscriberSourceResourceModel{
+				SourceName:    fwflex.StringToFramework(ctx, v.Value.SourceName),
+				SourceVersion: fwflex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		default:
+			continue
+		}
+
+		data = append(data, source)
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfSlice(ctx, data)
+}
+
+type subscriberResourceModel struct {
+	AccessType            fwtypes.StringEnum[awstypes.AccessType]                  `tfsdk:"access_type"`
+	ARN                   types.String                                             `tfsdk:"arn"`
+	ID                    types.String                                             `tfsdk:"id"`
+	ResourceShareARN      types.String                                             `tfsdk:"resource_share_arn"`
+	ResourceShareName     types.String                                             `tfsdk:"resource_share_name"`
+	RoleARN               types.String                                             `tfsdk:"role_arn"`
+	S3BucketARN           types.String                                             `tfsdk:"s3_bucket_arn"`
+	LogSources            fwtypes.SetNestedObjectValueOf[subscriberSourceModel]    `tfsdk:"source"`
+	SubscriberDescription types.String                                             `tfsdk:"subscriber_description"`
+	SubscriberEndpoint    types.String                                             `tfsdk:"subscriber_endpoint"`
+	SubscriberIdentity    fwtypes.ListNestedObjectValueOf[subscriberIdentityModel] `tfsdk:"subscriber_identity"`
+	SubscriberName        types.String                                             `tfsdk:"subscriber_name"`
+	SubscriberStatus      fwtypes.StringEnum[awstypes.SubscriberStatus]            `tfsdk:"subscriber_status"`
+	Tags                  types.Map                                                `tfsdk:"tags"`
+	TagsAll               types.Map                                                `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+type subscriberSourceModel struct {
+	AWSLogSourceResource    fwtypes.ListNestedObjectValueOf[subscriberSourceResourceModel] `tfsdk:"aws_log_source_resource"`
+	CustomLogSourceResource fwtypes.ListNestedObjectValueOf[subscriberSourceResourceModel] `tfsdk:"custom_log_source_resource"`
+}
+
+type subscriberSourceResourceModel struct {
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+type subscriberIdentityModel struct {
+	ExternalID types.String `tfsdk:"external_id"`
+	Principal  types.String `tfsdk:"principal"`
+}
+
+func (data *subscriberResourceModel) refreshFromOutput(ctx context.Context, apiObject *awstypes.SubscriberResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(apiObject.AccessTypes) > 0 {
+		data.AccessType = fwtypes.StringEnumValue(apiObject.AccessTypes[0])
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, apiObject.SubscriberArn)
+	data.ID = fwflex.StringToFramework(ctx, apiObject.SubscriberId)
+	// Sources is a union type and is flattened separately.
+	data.LogSources = flattenSubscriberSources(ctx, apiObject.Sources)
+
+	return diags
+}
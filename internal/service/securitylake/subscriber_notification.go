@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber Notification")
+func newSubscriberNotificationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &subscriberNotificationResource{}
+
+	return r, nil
+}
+
+type subscriberNotificationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *subscriberNotificationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber_notification"
+}
+
+func (r *subscriberNotificationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"subscriber_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberNotificationConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"https_notification_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[httpsNotificationConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authorization_api_key_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"authorization_api_key_value": schema.StringAttribute{
+										Optional:  true,
+										Sensitive: true,
+									},
+									"endpoint": schema.StringAttribute{
+										Required: true,
+									},
+									"http_method": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HttpMethod](),
+										Optional:   true,
+									},
+									"target_role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"sqs_notification_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sqsNotificationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+			},
+		},
+	}
+}
+
+func (r *subscriberNotificationResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("configuration").AtListIndex(0).AtName("https_notification_configuration"),
+			path.MatchRoot("configuration").AtListIndex(0).AtName("sqs_notification_configuration"),
+		),
+	}
+}
+
+func (r *subscriberNotificationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data subscriberNotificationResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	configuration, diags := expandSubscriberNotificationConfiguration(ctx, data.Configuration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateSubscriberNotificationInput{
+		Configuration: configuration,
+		SubscriberId:  fwflex.StringFromFramework(ctx, data.SubscriberID),
+	}
+
+	output, err := conn.CreateSubscriberNotification(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber Notification (%s)", data.SubscriberID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Endpoint = fwflex.StringToFramework(ctx, output.SubscriberEndpoint)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberNotificationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data subscriberNotificationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	output, err := findSubscriberNotificationBySubscriberID(ctx, conn, data.SubscriberID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber Notification (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The notification configuration is not returned by the API.
+	data.Endpoint = fwflex.StringToFramework(ctx, output.SubscriberEndpoint)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberNotificationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new subscriberNotificationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) {
+		configuration, diags := expandSubscriberNotificationConfiguration(ctx, new.Configuration)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &securitylake.UpdateSubscriberNotificationInput{
+			Configuration: configuration,
+			SubscriberId:  fwflex.StringFromFramework(ctx, new.SubscriberID),
+		}
+
+		output, err := conn.UpdateSubscriberNotification(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber Notification (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Endpoint = fwflex.StringToFramework(ctx, output.SubscriberEndpoint)
+	} else {
+		new.Endpoint = old.Endpoint
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *subscriberNotificationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data subscriberNotificationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteSubscriberNotification(ctx, &securitylake.DeleteSubscriberNotificationInput{
+		SubscriberId: fwflex.StringFromFramework(ctx, data.SubscriberID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber Notification (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findSubscriberNotificationBySubscriberID(ctx context.Context, conn *securitylake.Client, subscriberID string) (*awstypes.SubscriberResource, error) {
+	output, err := findSubscriberByID(ctx, conn, subscriberID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(output.SubscriberEndpoint) == "" {
+		return nil, tfresource.NewEmptyResultError(subscriberID)
+	}
+
+	return output, nil
+}
+
+func expandSubscriberNotificationConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[subscriberNotificationConfigurationModel]) (awstypes.NotificationConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	if !data.HTTPSNotificationConfiguration.IsNull() {
+		https, d := data.HTTPSNotificationConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.NotificationConfigurationMemberHttpsNotificationConfiguration
+		diags.Append(fwflex.Expand(ctx, https, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &apiObject, diags
+	}
+
+	if !data.SQSNotificationConfiguration.IsNull() {
+		return &awstypes.NotificationConfigurationMemberSqsNotificationConfiguration{}, diags
+	}
+
+	return nil, diags
+}
+
+type subscriberNotificationResourceModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[subscriberNotificationConfigurationModel] `tfsdk:"configuration"`
+	Endpoint      types.String                                                              `tfsdk:"endpoint"`
+	ID            types.String                                                              `tfsdk:"id"`
+	SubscriberID  types.String                                                              `tfsdk:"subscriber_id"`
+}
+
+type subscriberNotificationConfigurationModel struct {
+	HTTPSNotificationConfiguration fwtypes.ListNestedObjectValueOf[httpsNotificationConfigurationModel] `tfsdk:"https_notification_configuration"`
+	SQSNotificationConfiguration   fwtypes.ListNestedObjectValueOf[sqsNotificationConfigurationModel]   `tfsdk:"sqs_notification_configuration"`
+}
+
+type httpsNotificationConfigurationModel struct {
+	AuthorizationAPIKeyName  types.String                            `tfsdk:"authorization_api_key_name"`
+	AuthorizationAPIKeyValue types.String                            `tfsdk:"authorization_api_key_value"`
+	Endpoint                 types.String                            `tfsdk:"endpoint"`
+	HTTPMethod               fwtypes.StringEnum[awstypes.HttpMethod] `tfsdk:"http_method"`
+	TargetRoleARN            fwtypes.ARN                             `tfsdk:"target_role_arn"`
+}
+
+type sqsNotificationConfigurationModel struct{}
+
+func (data *subscriberNotificationResourceModel) InitFromID() {
+	data.SubscriberID = data.ID
+}
+
+func (data *subscriberNotificationResourceModel) setID() {
+	data.ID = data.SubscriberID
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSubscriberNotification_sqs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_subscriber_notification.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberNotificationConfig_sqs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriberNotificationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.sqs_notification_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.https_notification_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrPair(resourceName, "subscriber_id", "aws_securitylake_subscriber.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration"},
+			},
+		},
+	})
+}
+
+func testAccSubscriberNotification_https(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_subscriber_notification.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberNotificationConfig_https(rName, "https://example.com/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriberNotificationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.https_notification_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.https_notification_configuration.0.endpoint", "https://example.com/"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.https_notification_configuration.0.http_method", "POST"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.https_notification_configuration.0.target_role_arn", "aws_iam_role.event_bridge", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+				),
+			},
+			{
+				Config: testAccSubscriberNotificationConfig_https(rName, "https://example.com/updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriberNotificationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.https_notification_configuration.0.endpoint", "https://example.com/updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriberNotificationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securitylake_subscriber_notification" {
+				continue
+			}
+
+			_, err := tfsecuritylake.FindSubscriberNotificationBySubscriberID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Lake Subscriber Notification %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSubscriberNotificationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		_, err := tfsecuritylake.FindSubscriberNotificationBySubscriberID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSubscriberNotificationConfig_sqs(rName string) string {
+	return acctest.ConfigCompose(testAccSubscriberConfig_basic(rName, "test"), `
+resource "aws_securitylake_subscriber_notification" "test" {
+  subscriber_id = aws_securitylake_subscriber.test.id
+
+  configuration {
+    sqs_notification_configuration {}
+  }
+}
+`)
+}
+
+func testAccSubscriberNotificationConfig_https(rName, endpoint string) string {
+	return acctest.ConfigCompose(testAccSubscriberConfig_basic(rName, "test"), fmt.Sprintf(`
+resource "aws_iam_role" "event_bridge" {
+  name = "%[1]s-events"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "events.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_securitylake_subscriber_notification" "test" {
+  subscriber_id = aws_securitylake_subscriber.test.id
+
+  configuration {
+    https_notification_configuration {
+      endpoint        = %[2]q
+      http_method     = "POST"
+      target_role_arn = aws_iam_role.event_bridge.arn
+    }
+  }
+}
+`, rName, endpoint))
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// cedarSchemaType is a string type holding a Cedar schema in JSON format.
+// Values are semantically equal if their normalized forms are identical.
+type cedarSchemaType struct {
+	basetypes.StringType
+}
+
+var (
+	cedarSchemaStringType = cedarSchemaType{}
+)
+
+var (
+	_ xattr.TypeWithValidate                     = (*cedarSchemaType)(nil)
+	_ basetypes.StringTypable                    = (*cedarSchemaType)(nil)
+	_ basetypes.StringValuable                   = (*cedarSchema)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*cedarSchema)(nil)
+)
+
+func (t cedarSchemaType) Equal(o attr.Type) bool {
+	other, ok := o.(cedarSchemaType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t cedarSchemaType) String() string {
+	return "CedarSchemaType"
+}
+
+func (t cedarSchemaType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return cedarSchemaNull(), diags
+	}
+	if in.IsUnknown() {
+		return cedarSchemaUnknown(), diags
+	}
+
+	return cedarSchema{StringValue: in}, diags
+}
+
+func (t cedarSchemaType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t cedarSchemaType) ValueType(context.Context) attr.Value {
+	return cedarSchema{}
+}
+
+func (t cedarSchemaType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Terraform Value",
+			"An unexpected error occurred while attempting to convert a Terraform value to a string. "+
+				"This generally is an issue with the provider schema implementation. "+
+				"Please contact the provider developers.\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if _, err := normalizeCedarSchemaJSON(value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Cedar Schema Value",
+			"A string value was provided that is not a valid Cedar schema in JSON format.\n\n"+
+				"Path: "+path.String()+"\n"+
+				"Given Value: "+value+"\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func cedarSchemaNull() cedarSchema {
+	return cedarSchema{StringValue: basetypes.NewStringNull()}
+}
+
+func cedarSchemaUnknown() cedarSchema {
+	return cedarSchema{StringValue: basetypes.NewStringUnknown()}
+}
+
+func cedarSchemaValue(value string) cedarSchema {
+	return cedarSchema{StringValue: basetypes.NewStringValue(value)}
+}
+
+type cedarSchema struct {
+	basetypes.StringValue
+}
+
+func (v cedarSchema) Equal(o attr.Value) bool {
+	other, ok := o.(cedarSchema)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v cedarSchema) Type(context.Context) attr.Type {
+	return cedarSchemaStringType
+}
+
+func (v cedarSchema) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(cedarSchema)
+
+	if !ok {
+		return false, diags
+	}
+
+	return cedarSchemasEquivalent(v.ValueString(), newValue.ValueString()), diags
+}
+
+func cedarSchemasEquivalent(s1, s2 string) bool {
+	n1, err := normalizeCedarSchemaJSON(s1)
+
+	if err != nil {
+		return false
+	}
+
+	n2, err := normalizeCedarSchemaJSON(s2)
+
+	if err != nil {
+		return false
+	}
+
+	return n1 == n2
+}
+
+// normalizeCedarSchemaJSON returns a canonical form of a Cedar schema in JSON format.
+// Object keys are sorted, lists whose order is not significant to Cedar are sorted
+// and attributes explicitly marked as required (the default) have the marker removed.
+func normalizeCedarSchemaJSON(s string) (string, error) {
+	var v map[string]interface{}
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return "", err
+	}
+
+	for namespace, definition := range v {
+		if _, ok := definition.(map[string]interface{}); !ok {
+			return "", fmt.Errorf("namespace (%s) is not a JSON object", namespace)
+		}
+	}
+
+	b, err := json.Marshal(normalizeCedarSchemaValue(v))
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// cedarSchemaUnorderedLists are the Cedar schema keys whose list values are sets.
+var cedarSchemaUnorderedLists = map[string]bool{
+	"memberOf":       true,
+	"memberOfTypes":  true,
+	"principalTypes": true,
+	"resourceTypes":  true,
+}
+
+func normalizeCedarSchemaValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			e = normalizeCedarSchemaValue(e)
+
+			if l, ok := e.([]interface{}); ok && cedarSchemaUnorderedLists[k] {
+				sortCedarSchemaList(l)
+			}
+
+			v[k] = e
+		}
+
+		// "required" defaults to true for record attributes.
+		if _, ok := v["type"]; ok {
+			if required, ok := v["required"].(bool); ok && required {
+				delete(v, "required")
+			}
+		}
+
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = normalizeCedarSchemaValue(e)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+func sortCedarSchemaList(l []interface{}) {
+	key := func(v interface{}) string {
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+
+	sort.SliceStable(l, func(i, j int) bool {
+		return key(l[i]) < key(l[j])
+	})
+}
+
+// cedarSchemaNamespaces returns the namespaces declared in a Cedar schema in JSON format.
+func cedarSchemaNamespaces(s string) ([]string, error) {
+	var v map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]string, 0, len(v))
+	for k := range v {
+		namespaces = append(namespaces, k)
+	}
+
+	sort.Strings(namespaces)
+
+	return namespaces, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"testing"
+
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+)
+
+func TestNormalizeCedarSchemaJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:     "empty",
+			input:    `{}`,
+			expected: `{}`,
+		},
+		{
+			name:          "invalid JSON",
+			input:         `{"PhotoApp":`,
+			expectedError: true,
+		},
+		{
+			name:          "namespace not an object",
+			input:         `{"PhotoApp":[]}`,
+			expectedError: true,
+		},
+		{
+			name: "keys sorted",
+			input: `{
+  "PhotoApp": {
+    "entityTypes": {"User": {}},
+    "actions": {}
+  }
+}`,
+			expected: `{"PhotoApp":{"actions":{},"entityTypes":{"User":{}}}}`,
+		},
+		{
+			name:     "unordered lists sorted",
+			input:    `{"PhotoApp":{"actions":{"view":{"appliesTo":{"principalTypes":["User","Group"],"resourceTypes":["Photo","Album"]}}},"entityTypes":{"User":{"memberOfTypes":["Team","Group"]}}}}`,
+			expected: `{"PhotoApp":{"actions":{"view":{"appliesTo":{"principalTypes":["Group","User"],"resourceTypes":["Album","Photo"]}}},"entityTypes":{"User":{"memberOfTypes":["Group","Team"]}}}}`,
+		},
+		{
+			name:     "action groups sorted",
+			input:    `{"PhotoApp":{"actions":{"view":{"memberOf":[{"id":"read"},{"id":"all"}]}}}}`,
+			expected: `{"PhotoApp":{"actions":{"view":{"memberOf":[{"id":"all"},{"id":"read"}]}}}}`,
+		},
+		{
+			name:     "nested types",
+			input:    `{"PhotoApp":{"commonTypes":{"Tags":{"type":"Set","element":{"type":"String"}}},"entityTypes":{"User":{"shape":{"type":"Record","attributes":{"roles":{"type":"Extension","name":"ipaddr"}}}}}}}`,
+			expected: `{"PhotoApp":{"commonTypes":{"Tags":{"element":{"type":"String"},"type":"Set"}},"entityTypes":{"User":{"shape":{"attributes":{"roles":{"name":"ipaddr","type":"Extension"}},"type":"Record"}}}}}`,
+		},
+		{
+			name:     "required true removed",
+			input:    `{"PhotoApp":{"entityTypes":{"User":{"shape":{"type":"Record","attributes":{"name":{"type":"String","required":true},"age":{"type":"Long","required":false}}}}}}}`,
+			expected: `{"PhotoApp":{"entityTypes":{"User":{"shape":{"attributes":{"age":{"required":false,"type":"Long"},"name":{"type":"String"}},"type":"Record"}}}}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfverifiedpermissions.NormalizeCedarSchemaJSON(testCase.input)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("NormalizeCedarSchemaJSON(%q) err %t, want %t", testCase.input, got, want)
+			}
+
+			if err == nil && got != testCase.expected {
+				t.Errorf("NormalizeCedarSchemaJSON(%q) = %q, want %q", testCase.input, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestCedarSchemasEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		s1, s2   string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			s1:       `{"PhotoApp":{"entityTypes":{},"actions":{}}}`,
+			s2:       `{"PhotoApp":{"entityTypes":{},"actions":{}}}`,
+			expected: true,
+		},
+		{
+			name:     "whitespace",
+			s1:       `{"PhotoApp":{"entityTypes":{},"actions":{}}}`,
+			s2:       "{\n  \"PhotoApp\": {\n    \"actions\": {},\n    \"entityTypes\": {}\n  }\n}",
+			expected: true,
+		},
+		{
+			name:     "different namespace",
+			s1:       `{"PhotoApp":{"entityTypes":{},"actions":{}}}`,
+			s2:       `{"DocumentApp":{"entityTypes":{},"actions":{}}}`,
+			expected: false,
+		},
+		{
+			name:     "invalid",
+			s1:       `{"PhotoApp":{"entityTypes":{},"actions":{}}}`,
+			s2:       `PhotoApp`,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfverifiedpermissions.CedarSchemasEquivalent(testCase.s1, testCase.s2); got != testCase.expected {
+				t.Errorf("CedarSchemasEquivalent(%q, %q) = %t, want %t", testCase.s1, testCase.s2, got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+// Exports for use in tests only.
+var (
+	ResourceIdentitySource = newIdentitySourceResource
+	ResourcePolicy         = newPolicyResource
+	ResourcePolicyStore    = newPolicyStoreResource
+	ResourcePolicyTemplate = newPolicyTemplateResource
+	ResourceSchema         = newSchemaResource
+
+	CedarSchemasEquivalent         = cedarSchemasEquivalent
+	FindIdentitySourceByTwoPartKey = findIdentitySourceByTwoPartKey
+	FindPolicyByTwoPartKey         = findPolicyByTwoPartKey
+	FindPolicyStoreByID            = findPolicyStoreByID
+	FindPolicyTemplateByTwoPartKey = findPolicyTemplateByTwoPartKey
+	FindSchemaByPolicyStoreID      = findSchemaByPolicyStoreID
+	NormalizeCedarSchemaJSON       = normalizeCedarSchemaJSON
+)
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Source")
+func newIdentitySourceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &identitySourceResource{}
+
+	return r, nil
+}
+
+type identitySourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *identitySourceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_identity_source"
+}
+
+func (r *identitySourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"identity_source_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_entity_type": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identitySourceConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cognito_user_pool_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoUserPoolConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"client_ids": schema.ListAttribute{
+										CustomType:  fwtypes.NewListTypeOf[types.String](ctx),
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"user_pool_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.IsRequired(),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+			},
+		},
+	}
+}
+
+func (r *identitySourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data identitySourceResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	cognitoUserPoolConfiguration, diags := data.cognitoUserPoolConfiguration(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken:         aws.String(id.UniqueId()),
+		PolicyStoreId:       fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+		PrincipalEntityType: fwflex.StringFromFramework(ctx, data.PrincipalEntityType),
+	}
+
+	if cognitoUserPoolConfiguration != nil {
+		apiObject := &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, cognitoUserPoolConfiguration, &apiObject.Value)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Configuration = apiObject
+	}
+
+	output, err := conn.CreateIdentitySource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Identity Source (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.IdentitySourceID = fwflex.StringToFramework(ctx, output.IdentitySourceId)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *identitySourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data identitySourceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findIdentitySourceByTwoPartKey(ctx, conn, data.PolicyStoreID.ValueString(), data.IdentitySourceID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Configuration = fwtypes.NewListNestedObjectValueOfPtr(ctx, &identitySourceConfigurationModel{
+		CognitoUserPoolConfiguration: fwtypes.NewListNestedObjectValueOfPtr(ctx, &cognitoUserPoolConfigurationModel{
+			ClientIDs:   fwtypes.ListValueOf[types.String]{ListValue: fwflex.FlattenFrameworkStringValueList(ctx, output.Details.ClientIds)},
+			UserPoolARN: fwflex.StringToFrameworkARN(ctx, output.Details.UserPoolArn),
+		}),
+	})
+	data.PrincipalEntityType = fwflex.StringToFramework(ctx, output.PrincipalEntityType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *identitySourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new identitySourceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) || !new.PrincipalEntityType.Equal(old.PrincipalEntityType) {
+		cognitoUserPoolConfiguration, diags := new.cognitoUserPoolConfiguration(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &verifiedpermissions.UpdateIdentitySourceInput{
+			IdentitySourceId:    fwflex.StringFromFramework(ctx, new.IdentitySourceID),
+			PolicyStoreId:       fwflex.StringFromFramework(ctx, new.PolicyStoreID),
+			PrincipalEntityType: fwflex.StringFromFramework(ctx, new.PrincipalEntityType),
+		}
+
+		if cognitoUserPoolConfiguration != nil {
+			apiObject := &awstypes.UpdateConfigurationMemberCognitoUserPoolConfiguration{}
+			response.Diagnostics.Append(fwflex.Expand(ctx, cognitoUserPoolConfiguration, &apiObject.Value)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.UpdateConfiguration = apiObject
+		}
+
+		_, err := conn.UpdateIdentitySource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Identity Source (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *identitySourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data identitySourceResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	_, err := conn.DeleteIdentitySource(ctx, &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: fwflex.StringFromFramework(ctx, data.IdentitySourceID),
+		PolicyStoreId:    fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findIdentitySourceByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, identitySourceID string) (*verifiedpermissions.GetIdentitySourceOutput, error) {
+	input := &verifiedpermissions.GetIdentitySourceInput{
+		IdentitySourceId: aws.String(identitySourceID),
+		PolicyStoreId:    aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetIdentitySource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Details == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type identitySourceResourceModel struct {
+	Configuration       fwtypes.ListNestedObjectValueOf[identitySourceConfigurationModel] `tfsdk:"configuration"`
+	ID                  types.String                                                      `tfsdk:"id"`
+	IdentitySourceID    types.String                                                      `tfsdk:"identity_source_id"`
+	PolicyStoreID       types.String                                                      `tfsdk:"policy_store_id"`
+	PrincipalEntityType types.String                                                      `tfsdk:"principal_entity_type"`
+}
+
+type identitySourceConfigurationModel struct {
+	CognitoUserPoolConfiguration fwtypes.ListNestedObjectValueOf[cognitoUserPoolConfigurationModel] `tfsdk:"cognito_user_pool_configuration"`
+}
+
+type cognitoUserPoolConfigurationModel struct {
+	ClientIDs   fwtypes.ListValueOf[types.String] `tfsdk:"client_ids"`
+	UserPoolARN fwtypes.ARN                       `tfsdk:"user_pool_arn"`
+}
+
+func (data *identitySourceResourceModel) cognitoUserPoolConfiguration(ctx context.Context) (*cognitoUserPoolConfigurationModel, diag.Diagnostics) {
+	configuration, diags := data.Configuration.ToPtr(ctx)
+	if diags.HasError() || configuration == nil {
+		return nil, diags
+	}
+
+	return configuration.CognitoUserPoolConfiguration.ToPtr(ctx)
+}
+
+const (
+	identitySourceResourceIDPartCount = 2
+)
+
+func (data *identitySourceResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, identitySourceResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.PolicyStoreID = types.StringValue(parts[0])
+	data.IdentitySourceID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *identitySourceResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.PolicyStoreID.ValueString(), data.IdentitySourceID.ValueString()}, identitySourceResourceIDPartCount, false)))
+}
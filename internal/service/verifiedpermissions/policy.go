@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy")
+func newPolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &policyResource{}
+
+	return r, nil
+}
+
+type policyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *policyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy"
+}
+
+func (r *policyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[entityIdentifierModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"entity_id": schema.StringAttribute{
+					Required: true,
+				},
+				"entity_type": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PolicyType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDefinitionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"static": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[staticPolicyDefinitionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDescription: schema.StringAttribute{
+										Optional: true,
+									},
+									"statement": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplaceIf(requiresReplaceIfPresenceChanges,
+									"If the value of this attribute is added or removed, Terraform will destroy and recreate the resource.",
+									"If the value of this attribute is added or removed, Terraform will destroy and recreate the resource.",
+								),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("static"),
+									path.MatchRelative().AtParent().AtName("template_linked"),
+								),
+							},
+						},
+						"template_linked": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateLinkedPolicyDefinitionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"policy_template_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"principal": entityIdentifierBlock,
+									"resource":  entityIdentifierBlock,
+								},
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+			},
+		},
+	}
+}
+
+func (r *policyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data policyResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	definition, diags := expandPolicyDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Definition:    definition,
+		PolicyStoreId: fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Policy (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PolicyID = fwflex.StringToFramework(ctx, output.PolicyId)
+	data.PolicyType = fwtypes.StringEnumValue(output.PolicyType)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *policyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data policyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findPolicyByTwoPartKey(ctx, conn, data.PolicyStoreID.ValueString(), data.PolicyID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	definition, diags := flattenPolicyDefinitionDetail(ctx, output.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Definition = definition
+	data.PolicyType = fwtypes.StringEnumValue(output.PolicyType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *policyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new policyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		definition, diags := new.Definition.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Only static policies can be updated in-place.
+		static, diags := definition.Static.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &verifiedpermissions.UpdatePolicyInput{
+			PolicyId:      fwflex.StringFromFramework(ctx, new.PolicyID),
+			PolicyStoreId: fwflex.StringFromFramework(ctx, new.PolicyStoreID),
+		}
+
+		if static != nil {
+			apiObject := awstypes.UpdateStaticPolicyDefinition{}
+			response.Diagnostics.Append(fwflex.Expand(ctx, static, &apiObject)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.Definition = &awstypes.UpdatePolicyDefinitionMemberStatic{
+				Value: apiObject,
+			}
+		}
+
+		_, err := conn.UpdatePolicy(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Policy (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *policyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data policyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	_, err := conn.DeletePolicy(ctx, &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      fwflex.StringFromFramework(ctx, data.PolicyID),
+		PolicyStoreId: fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// requiresReplaceIfPresenceChanges forces replacement when a nested block is added or removed.
+func requiresReplaceIfPresenceChanges(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	response.RequiresReplace = len(request.StateValue.Elements()) != len(request.PlanValue.Elements())
+}
+
+func findPolicyByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyID string) (*verifiedpermissions.GetPolicyOutput, error) {
+	input := &verifiedpermissions.GetPolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandPolicyDefinition(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[policyDefinitionModel]) (awstypes.PolicyDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	definition, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || definition == nil {
+		return nil, diags
+	}
+
+	static, d := definition.Static.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if static != nil {
+		apiObject := &awstypes.PolicyDefinitionMemberStatic{}
+		diags.Append(fwflex.Expand(ctx, static, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	templateLinked, d := definition.TemplateLinked.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if templateLinked != nil {
+		apiObject := &awstypes.PolicyDefinitionMemberTemplateLinked{}
+		diags.Append(fwflex.Expand(ctx, templateLinked, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func flattenPolicyDefinitionDetail(ctx context.Context, apiObject awstypes.PolicyDefinitionDetail) (fwtypes.ListNestedObjectValueOf[policyDefinitionModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	definition := &policyDefinitionModel{
+		Static:         fwtypes.NewListNestedObjectValueOfNull[staticPolicyDefinitionModel](ctx),
+		TemplateLinked: fwtypes.NewListNestedObjectValueOfNull[templateLinkedPolicyDefinitionModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyDefinitionDetailMemberStatic:
+		var static staticPolicyDefinitionModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &static)...)
+		definition.Static = fwtypes.NewListNestedObjectValueOfPtr(ctx, &static)
+
+	case *awstypes.PolicyDefinitionDetailMemberTemplateLinked:
+		var templateLinked templateLinkedPolicyDefinitionModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &templateLinked)...)
+		definition.TemplateLinked = fwtypes.NewListNestedObjectValueOfPtr(ctx, &templateLinked)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, definition), diags
+}
+
+type policyResourceModel struct {
+	Definition    fwtypes.ListNestedObjectValueOf[policyDefinitionModel] `tfsdk:"definition"`
+	ID            types.String                                           `tfsdk:"id"`
+	PolicyID      types.String                                           `tfsdk:"policy_id"`
+	PolicyStoreID types.String                                           `tfsdk:"policy_store_id"`
+	PolicyType    fwtypes.StringEnum[awstypes.PolicyType]                `tfsdk:"policy_type"`
+}
+
+type policyDefinitionModel struct {
+	Static         fwtypes.ListNestedObjectValueOf[staticPolicyDefinitionModel]         `tfsdk:"static"`
+	TemplateLinked fwtypes.ListNestedObjectValueOf[templateLinkedPolicyDefinitionModel] `tfsdk:"template_linked"`
+}
+
+type staticPolicyDefinitionModel struct {
+	Description types.String `tfsdk:"description"`
+	Statement   types.String `tfsdk:"statement"`
+}
+
+type templateLinkedPolicyDefinitionModel struct {
+	PolicyTemplateID types.String                                           `tfsdk:"policy_template_id"`
+	Principal        fwtypes.ListNestedObjectValueOf[entityIdentifierModel] `tfsdk:"principal"`
+	Resource         fwtypes.ListNestedObjectValueOf[entityIdentifierModel] `tfsdk:"resource"`
+}
+
+type entityIdentifierModel struct {
+	EntityID   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+const (
+	policyResourceIDPartCount = 2
+)
+
+func (data *policyResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, policyResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.PolicyStoreID = types.StringValue(parts[0])
+	data.PolicyID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *policyResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.PolicyStoreID.ValueString(), data.PolicyID.ValueString()}, policyResourceIDPartCount, false)))
+}
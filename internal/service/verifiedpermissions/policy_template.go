@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy Template")
+func newPolicyTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &policyTemplateResource{}
+
+	return r, nil
+}
+
+type policyTemplateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *policyTemplateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy_template"
+}
+
+func (r *policyTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"statement": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *policyTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data policyTemplateResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.CreatePolicyTemplateInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.CreatePolicyTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Policy Template (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PolicyTemplateID = fwflex.StringToFramework(ctx, output.PolicyTemplateId)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *policyTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data policyTemplateResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findPolicyTemplateByTwoPartKey(ctx, conn, data.PolicyStoreID.ValueString(), data.PolicyTemplateID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Policy Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *policyTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new policyTemplateResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Statement.Equal(old.Statement) {
+		input := &verifiedpermissions.UpdatePolicyTemplateInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePolicyTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Policy Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *policyTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data policyTemplateResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	_, err := conn.DeletePolicyTemplate(ctx, &verifiedpermissions.DeletePolicyTemplateInput{
+		PolicyStoreId:    fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+		PolicyTemplateId: fwflex.StringFromFramework(ctx, data.PolicyTemplateID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Policy Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findPolicyTemplateByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyTemplateID string) (*verifiedpermissions.GetPolicyTemplateOutput, error) {
+	input := &verifiedpermissions.GetPolicyTemplateInput{
+		PolicyStoreId:    aws.String(policyStoreID),
+		PolicyTemplateId: aws.String(policyTemplateID),
+	}
+
+	output, err := conn.GetPolicyTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type policyTemplateResourceModel struct {
+	Description      types.String `tfsdk:"description"`
+	ID               types.String `tfsdk:"id"`
+	PolicyStoreID    types.String `tfsdk:"policy_store_id"`
+	PolicyTemplateID types.String `tfsdk:"policy_template_id"`
+	Statement        types.String `tfsdk:"statement"`
+}
+
+const (
+	policyTemplateResourceIDPartCount = 2
+)
+
+func (data *policyTemplateResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, policyTemplateResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.PolicyStoreID = types.StringValue(parts[0])
+	data.PolicyTemplateID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *policyTemplateResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.PolicyStoreID.ValueString(), data.PolicyTemplateID.ValueString()}, policyTemplateResourceIDPartCount, false)))
+}
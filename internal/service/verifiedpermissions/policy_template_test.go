@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicyTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_policy_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateConfig_basic("permit (principal == ?principal, action in PhotoApp::Action::\"viewPhoto\", resource == ?resource);"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPolicyTemplateExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "statement", "permit (principal == ?principal, action in PhotoApp::Action::\"viewPhoto\", resource == ?resource);"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_policy_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateConfig_basic("permit (principal == ?principal, action in PhotoApp::Action::\"viewPhoto\", resource == ?resource);"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicyTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_policy_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateConfig_basic("permit (principal == ?principal, action in PhotoApp::Action::\"viewPhoto\", resource == ?resource);"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccPolicyTemplateConfig_description("forbid (principal == ?principal, action, resource == ?resource);", "Deny all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Deny all"),
+					resource.TestCheckResourceAttr(resourceName, "statement", "forbid (principal == ?principal, action, resource == ?resource);"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_policy_template" {
+				continue
+			}
+
+			_, err := tfverifiedpermissions.FindPolicyTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["policy_template_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Verified Permissions Policy Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyTemplateExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		_, err := tfverifiedpermissions.FindPolicyTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["policy_template_id"])
+
+		return err
+	}
+}
+
+func testAccPolicyTemplateConfig_basic(statement string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  statement       = %[1]q
+}
+`, statement)
+}
+
+func testAccPolicyTemplateConfig_description(statement, description string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  statement       = %[1]q
+  description     = %[2]q
+}
+`, statement, description)
+}
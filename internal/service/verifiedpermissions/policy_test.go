@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicy_static(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_static("permit (principal, action == Action::\"view\", resource);", "Allow view"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", "Allow view"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", "permit (principal, action == Action::\"view\", resource);"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "STATIC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConfig_static("permit (principal, action == Action::\"edit\", resource);", "Allow edit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", "Allow edit"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", "permit (principal, action == Action::\"edit\", resource);"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_static("permit (principal, action == Action::\"view\", resource);", "Allow view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_templateLinked(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_templateLinked("alice"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.template_linked.0.policy_template_id", "aws_verifiedpermissions_policy_template.test", "policy_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_id", "alice"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_type", "User"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.0.entity_id", "vacation.jpg"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.0.entity_type", "Photo"),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "TEMPLATE_LINKED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConfig_templateLinked("bob"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.principal.0.entity_id", "bob"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_policy" {
+				continue
+			}
+
+			_, err := tfverifiedpermissions.FindPolicyByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["policy_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Verified Permissions Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		_, err := tfverifiedpermissions.FindPolicyByTwoPartKey(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["policy_id"])
+
+		return err
+	}
+}
+
+const testAccPolicyConfig_base = `
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+`
+
+func testAccPolicyConfig_static(statement, description string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_base, fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      description = %[2]q
+      statement   = %[1]q
+    }
+  }
+}
+`, statement, description))
+}
+
+func testAccPolicyConfig_templateLinked(principal string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_base, fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  statement       = "permit (principal == ?principal, action == Action::\"view\", resource == ?resource);"
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    template_linked {
+      policy_template_id = aws_verifiedpermissions_policy_template.test.policy_template_id
+
+      principal {
+        entity_id   = %[1]q
+        entity_type = "User"
+      }
+
+      resource {
+        entity_id   = "vacation.jpg"
+        entity_type = "Photo"
+      }
+    }
+  }
+}
+`, principal))
+}
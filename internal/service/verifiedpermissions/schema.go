@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// emptyCedarSchema is the schema of a policy store with no schema defined.
+	emptyCedarSchema = "{}"
+)
+
+// @FrameworkResource(name="Schema")
+func newSchemaResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &schemaResource{}
+
+	return r, nil
+}
+
+type schemaResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *schemaResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_schema"
+}
+
+func (r *schemaResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"namespaces": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[schemaDefinitionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							CustomType: cedarSchemaStringType,
+							Required:   true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+			},
+		},
+	}
+}
+
+func (r *schemaResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data schemaResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	definition, diags := data.Definition.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := putSchema(ctx, conn, data.PolicyStoreID.ValueString(), definition.Value.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Schema (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Namespaces = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, output.Namespaces)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *schemaResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data schemaResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.InitFromID()
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findSchemaByPolicyStoreID(ctx, conn, data.PolicyStoreID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	value := aws.ToString(output.Schema)
+	namespaces, err := cedarSchemaNamespaces(value)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Definition = fwtypes.NewListNestedObjectValueOfPtr(ctx, &schemaDefinitionModel{
+		Value: cedarSchemaValue(value),
+	})
+	data.Namespaces = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, namespaces)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *schemaResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new schemaResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		definition, diags := new.Definition.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := putSchema(ctx, conn, new.PolicyStoreID.ValueString(), definition.Value.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Schema (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Namespaces = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, output.Namespaces)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *schemaResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data schemaResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	// There is no API to delete a schema. Replace it with an empty one.
+	_, err := putSchema(ctx, conn, data.PolicyStoreID.ValueString(), emptyCedarSchema)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func putSchema(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, definition string) (*verifiedpermissions.PutSchemaOutput, error) {
+	input := &verifiedpermissions.PutSchemaInput{
+		Definition: &awstypes.SchemaDefinitionMemberCedarJson{
+			Value: definition,
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	return conn.PutSchema(ctx, input)
+}
+
+func findSchemaByPolicyStoreID(ctx context.Context, conn *verifiedpermissions.Client, id string) (*verifiedpermissions.GetSchemaOutput, error) {
+	input := &verifiedpermissions.GetSchemaInput{
+		PolicyStoreId: aws.String(id),
+	}
+
+	output, err := conn.GetSchema(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Schema == nil || cedarSchemasEquivalent(aws.ToString(output.Schema), emptyCedarSchema) {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type schemaResourceModel struct {
+	Definition    fwtypes.ListNestedObjectValueOf[schemaDefinitionModel] `tfsdk:"definition"`
+	ID            types.String                                           `tfsdk:"id"`
+	Namespaces    types.Set                                              `tfsdk:"namespaces"`
+	PolicyStoreID types.String                                           `tfsdk:"policy_store_id"`
+}
+
+type schemaDefinitionModel struct {
+	Value cedarSchema `tfsdk:"value"`
+}
+
+func (data *schemaResourceModel) InitFromID() {
+	data.PolicyStoreID = data.ID
+}
+
+func (data *schemaResourceModel) setID() {
+	data.ID = data.PolicyStoreID
+}
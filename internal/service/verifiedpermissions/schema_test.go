@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsSchema_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_basic("PhotoApp"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "definition.0.value"),
+					resource.TestCheckResourceAttr(resourceName, "namespaces.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "namespaces.*", "PhotoApp"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsSchema_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_basic("PhotoApp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourceSchema, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsSchema_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_basic("PhotoApp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "namespaces.*", "PhotoApp"),
+				),
+			},
+			{
+				Config: testAccSchemaConfig_basic("DocumentApp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "namespaces.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "namespaces.*", "DocumentApp"),
+				),
+			},
+		},
+	})
+}
+
+// Reordering set-like lists and explicitly marking attributes as required must not cause a diff.
+func TestAccVerifiedPermissionsSchema_equivalent(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_verifiedpermissions_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_basic("PhotoApp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName),
+				),
+			},
+			{
+				Config:   testAccSchemaConfig_equivalent("PhotoApp"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_schema" {
+				continue
+			}
+
+			_, err := tfverifiedpermissions.FindSchemaByPolicyStoreID(ctx, conn, rs.Primary.Attributes["policy_store_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Verified Permissions Schema %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSchemaExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		_, err := tfverifiedpermissions.FindSchemaByPolicyStoreID(ctx, conn, rs.Primary.Attributes["policy_store_id"])
+
+		return err
+	}
+}
+
+func testAccSchemaConfig_basic(namespace string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    value = jsonencode({
+      %[1]q = {
+        entityTypes = {
+          User = {
+            shape = {
+              type = "Record"
+              attributes = {
+                name = {
+                  type = "String"
+                }
+              }
+            }
+          }
+          Group = {}
+          Photo = {}
+        }
+        actions = {
+          viewPhoto = {
+            appliesTo = {
+              principalTypes = ["User", "Group"]
+              resourceTypes  = ["Photo"]
+            }
+          }
+        }
+      }
+    })
+  }
+}
+`, namespace)
+}
+
+func testAccSchemaConfig_equivalent(namespace string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    value = jsonencode({
+      %[1]q = {
+        actions = {
+          viewPhoto = {
+            appliesTo = {
+              resourceTypes  = ["Photo"]
+              principalTypes = ["Group", "User"]
+            }
+          }
+        }
+        entityTypes = {
+          Photo = {}
+          Group = {}
+          User = {
+            shape = {
+              type = "Record"
+              attributes = {
+                name = {
+                  type     = "String"
+                  required = true
+                }
+              }
+            }
+          }
+        }
+      }
+    })
+  }
+}
+`, namespace)
+}
@@ -19,7 +19,28 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newIdentitySourceResource,
+			Name:    "Identity Source",
+		},
+		{
+			Factory: newPolicyResource,
+			Name:    "Policy",
+		},
+		{
+			Factory: newPolicyStoreResource,
+			Name:    "Policy Store",
+		},
+		{
+			Factory: newPolicyTemplateResource,
+			Name:    "Policy Template",
+		},
+		{
+			Factory: newSchemaResource,
+			Name:    "Schema",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {